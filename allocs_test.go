@@ -0,0 +1,75 @@
+package ziggurat_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/argusdusty/ziggurat"
+	"github.com/argusdusty/ziggurat/zigsrc"
+	"github.com/vpxyz/xorshift/xoroshiro128plus"
+	"gonum.org/v1/gonum/stat/distuv"
+)
+
+const ALLOCS_RUNS = 100_000
+
+var ALLOCS_SAMPLERS = []struct {
+	Name string
+	Fn   func() ziggurat.Sampler
+}{
+	{Name: "Ziggurat", Fn: func() ziggurat.Sampler { return ziggurat.ToZiggurat(UnitHalfNormal{}, xoroshiro128plus.NewSource(1)) }},
+	{Name: "SymmetricZiggurat", Fn: func() ziggurat.Sampler {
+		return ziggurat.ToSymmetricZiggurat(distuv.StudentsT{Mu: 0.0, Sigma: 1.0, Nu: 1.0}, xoroshiro128plus.NewSource(1))
+	}},
+	{Name: "FlippedZiggurat", Fn: func() ziggurat.Sampler {
+		return ziggurat.ToZiggurat(NegUnitHalfNormal{}, xoroshiro128plus.NewSource(1))
+	}},
+	{Name: "TwoPartZiggurat", Fn: func() ziggurat.Sampler { return ziggurat.ToZiggurat(distuv.UnitNormal, xoroshiro128plus.NewSource(1)) }},
+	{Name: "InfinitePeak", Fn: func() ziggurat.Sampler {
+		return ziggurat.ToZiggurat(distuv.Gamma{Alpha: 0.5, Beta: 1.0}, xoroshiro128plus.NewSource(1))
+	}},
+	{Name: "MixtureZiggurat", Fn: func() ziggurat.Sampler {
+		return ziggurat.ToMixtureZiggurat([]float64{1, 2}, []ziggurat.Distribution{distuv.UnitNormal, distuv.Gamma{Alpha: 2.5, Beta: 1.0}}, xoroshiro128plus.NewSource(1))
+	}},
+	{Name: "TruncatedZiggurat", Fn: func() ziggurat.Sampler {
+		return ziggurat.ToTruncatedZiggurat(distuv.UnitNormal, 1, math.Inf(1), xoroshiro128plus.NewSource(1))
+	}},
+	{Name: "LocationScale", Fn: func() ziggurat.Sampler {
+		return ziggurat.ToZiggurat(distuv.UnitNormal, xoroshiro128plus.NewSource(1)).WithLocationScale(3, 2)
+	}},
+	{Name: "BatchSource", Fn: func() ziggurat.Sampler {
+		return ziggurat.ToSymmetricZiggurat(distuv.UnitNormal, zigsrc.NewXoroshiro128Plus(1))
+	}},
+}
+
+// Sampling must not allocate, including in the rejection, tail and infinite-peak paths.
+func TestAllocs(t *testing.T) {
+	for _, s := range ALLOCS_SAMPLERS {
+		t.Run(s.Name, func(t *testing.T) {
+			Z := s.Fn()
+			if allocs := testing.AllocsPerRun(ALLOCS_RUNS, func() { Z.Rand() }); allocs != 0 {
+				t.Errorf("Rand made %v allocations per sample", allocs)
+			}
+			var dst = make([]float64, 64)
+			if allocs := testing.AllocsPerRun(ALLOCS_RUNS/len(dst), func() { Z.Fill(dst) }); allocs != 0 {
+				t.Errorf("Fill made %v allocations per call", allocs)
+			}
+		})
+	}
+	t.Run("BatchSource32", func(t *testing.T) {
+		Z := ziggurat.ToSymmetricZiggurat32(distuv.UnitNormal, zigsrc.NewXoroshiro128Plus(1))
+		var dst = make([]float32, 64)
+		if allocs := testing.AllocsPerRun(ALLOCS_RUNS/len(dst), func() { Z.Fill(dst) }); allocs != 0 {
+			t.Errorf("Fill made %v allocations per call", allocs)
+		}
+	})
+	t.Run("DiscreteZiggurat", func(t *testing.T) {
+		Z := ziggurat.ToDiscreteZiggurat(Poisson{distuv.Poisson{Lambda: 3}}, xoroshiro128plus.NewSource(1))
+		if allocs := testing.AllocsPerRun(ALLOCS_RUNS, func() { Z.Rand() }); allocs != 0 {
+			t.Errorf("Rand made %v allocations per sample", allocs)
+		}
+		var dst = make([]int64, 64)
+		if allocs := testing.AllocsPerRun(ALLOCS_RUNS/len(dst), func() { Z.Fill(dst) }); allocs != 0 {
+			t.Errorf("Fill made %v allocations per call", allocs)
+		}
+	})
+}
@@ -15,8 +15,9 @@ const (
 )
 
 var (
-	// Because the Beta distribution is not unimodal for alpha<1, beta<1, or alpha=beta=1, we cannot construct Ziggurats for these cases.
-	BETA_PARAMS = [][2]float64{{2, 1}, {1.5, 1.5}, {4, 4}, {5, 2}, {10, 10}, {100, 250}}
+	// Because the Beta distribution is not unimodal for both alpha<=1 and beta<=1, we cannot construct Ziggurats for these cases.
+	// With only one of alpha<1 or beta<1, the density has an infinite peak at 0 or 1.
+	BETA_PARAMS = [][2]float64{{2, 1}, {1.5, 1.5}, {4, 4}, {5, 2}, {10, 10}, {100, 250}, {0.5, 2}, {2, 0.5}, {0.1, 3}}
 )
 
 func TestBeta(t *testing.T) {
@@ -0,0 +1,52 @@
+package ziggurat
+
+import "math/rand/v2"
+
+// Concurrent returns n clones of s for use by separate goroutines, sharing its tables.
+// The clones draw from independent streams derived deterministically from seed, so results are reproducible.
+func Concurrent(s Sampler, seed uint64, n int) []Sampler {
+	clones := make([]Sampler, n)
+	for i := range clones {
+		clones[i] = s.Clone(StreamSource(seed, uint64(i)))
+	}
+	return clones
+}
+
+func (z *ziggurat) Clone(src rand.Source) Sampler {
+	if src == nil {
+		src = globalRand{}
+	}
+	v := *z
+	v.src, v.random = src, nil
+	return &v
+}
+
+func (z symmetricZiggurat) Clone(src rand.Source) Sampler {
+	return symmetricZiggurat{r: z.r.Clone(src).(*ziggurat)}
+}
+
+func (z *flippedZiggurat) Clone(src rand.Source) Sampler {
+	return &flippedZiggurat{Sampler: z.Sampler.Clone(src), mode: z.mode}
+}
+
+func (z *twoPartZiggurat) Clone(src rand.Source) Sampler {
+	if src == nil {
+		src = globalRand{}
+	}
+	return &twoPartZiggurat{rightSideProb: z.rightSideProb, leftSide: z.leftSide.Clone(src), rightSide: z.rightSide.Clone(src), src: src}
+}
+
+func (z *mixtureZiggurat) Clone(src rand.Source) Sampler {
+	if src == nil {
+		src = globalRand{}
+	}
+	parts := make([]Sampler, len(z.parts))
+	for i, part := range z.parts {
+		parts[i] = part.Clone(src)
+	}
+	return &mixtureZiggurat{alias: z.alias, parts: parts, src: src}
+}
+
+func (z *discreteZiggurat) Clone(src rand.Source) DiscreteSampler {
+	return &discreteZiggurat{s: z.s.Clone(src)}
+}
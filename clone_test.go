@@ -0,0 +1,77 @@
+package ziggurat_test
+
+import (
+	"math/rand/v2"
+	"sync"
+	"testing"
+
+	"github.com/argusdusty/ziggurat"
+	"github.com/vpxyz/xorshift/xoroshiro128plus"
+)
+
+const CONCURRENT_WORKERS = 8
+
+// A clone must produce exactly the samples of a sampler built with the same source.
+func TestClone(t *testing.T) {
+	for _, d := range FILL_DISTRIBUTIONS {
+		t.Run(d.Name, func(t *testing.T) {
+			Z := d.Fn(d.Dist, xoroshiro128plus.NewSource(1))
+			C := d.Fn(d.Dist, xoroshiro128plus.NewSource(2)).Clone(xoroshiro128plus.NewSource(1))
+			for i := range FILL_SAMPLES {
+				if want, got := Z.Rand(), C.Rand(); got != want {
+					t.Fatalf("Clone sample %d = %v, want %v", i, got, want)
+				}
+			}
+		})
+	}
+}
+
+// Concurrent samplers must be reproducible from the seed, with a different stream for each worker.
+func TestConcurrent(t *testing.T) {
+	for _, d := range FILL_DISTRIBUTIONS {
+		t.Run(d.Name, func(t *testing.T) {
+			Z := d.Fn(d.Dist, nil)
+			run := func() [][]float64 {
+				samples := make([][]float64, CONCURRENT_WORKERS)
+				var wg sync.WaitGroup
+				for i, C := range ziggurat.Concurrent(Z, 42, CONCURRENT_WORKERS) {
+					samples[i] = make([]float64, FILL_SAMPLES)
+					wg.Add(1)
+					go func() {
+						defer wg.Done()
+						C.Fill(samples[i])
+					}()
+				}
+				wg.Wait()
+				return samples
+			}
+			first, second := run(), run()
+			for i := range first {
+				for j := range first[i] {
+					if first[i][j] != second[i][j] {
+						t.Fatalf("Worker %d sample %d = %v, then %v", i, j, first[i][j], second[i][j])
+					}
+				}
+				for k := range i {
+					if first[i][0] == first[k][0] && first[i][1] == first[k][1] {
+						t.Errorf("Workers %d and %d produced the same samples", k, i)
+					}
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkConcurrent(b *testing.B) {
+	for _, d := range FILL_DISTRIBUTIONS {
+		b.Run(d.Name, func(b *testing.B) {
+			Z := d.Fn(d.Dist, nil)
+			b.RunParallel(func(pb *testing.PB) {
+				C := Z.Clone(ziggurat.StreamSource(42, rand.Uint64()))
+				for pb.Next() {
+					C.Rand()
+				}
+			})
+		})
+	}
+}
@@ -0,0 +1,9 @@
+// Package example holds samplers generated by ziggen, covering each shape of ziggurat it can generate.
+package example
+
+//go:generate go run .. -dist normal -name Normal -package example -o normal.go
+//go:generate go run .. -dist gamma -alpha 2.5 -name Gamma -package example -strips 256 -o gamma.go
+//go:generate go run .. -dist gamma -alpha 0.5 -name SmallGamma -package example -strips 256 -o smallgamma.go
+//go:generate go run .. -dist gamma -alpha 0.05 -tail auto -name TinyGamma -package example -strips 256 -o tinygamma.go
+//go:generate go run .. -dist studentst -nu 3 -tail pareto -name StudentsT -package example -strips 256 -o studentst.go
+//go:generate go run .. -dist triangle -a 0 -b 1 -c 1 -name Triangle -package example -strips 256 -o triangle.go
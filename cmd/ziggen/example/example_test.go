@@ -0,0 +1,104 @@
+package example
+
+import (
+	"math"
+	"math/rand/v2"
+	"sort"
+	"testing"
+
+	"github.com/argusdusty/ziggurat"
+	"github.com/vpxyz/xorshift/xoroshiro128plus"
+	"gonum.org/v1/gonum/stat/distuv"
+)
+
+const (
+	EXAMPLE_SAMPLES = 100_000
+	// The Kolmogorov-Smirnov critical value of sqrt(n)*D for a false positive rate of 0.001.
+	EXAMPLE_KS_CRITICAL = 1.95
+)
+
+type CDFDistribution interface {
+	CDF(x float64) float64
+	Mean() float64
+	StdDev() float64
+}
+
+func testExample(t *testing.T, rng interface{ Rand() float64 }, dist CDFDistribution) {
+	var samples = make([]float64, EXAMPLE_SAMPLES)
+	for i := range samples {
+		samples[i] = rng.Rand()
+	}
+	sort.Float64s(samples)
+	var D, sum float64
+	for i, x := range samples {
+		F := dist.CDF(x)
+		D = max(D, F-float64(i)/EXAMPLE_SAMPLES, float64(i+1)/EXAMPLE_SAMPLES-F)
+		sum += x
+	}
+	if D*math.Sqrt(EXAMPLE_SAMPLES) > EXAMPLE_KS_CRITICAL {
+		t.Errorf("%s produced incorrect distribution with Kolmogorov-Smirnov statistic sqrt(n)*D=%v", t.Name(), D*math.Sqrt(EXAMPLE_SAMPLES))
+	}
+	if z := (sum/EXAMPLE_SAMPLES - dist.Mean()) / (dist.StdDev() / math.Sqrt(EXAMPLE_SAMPLES)); math.Abs(z) > 4 {
+		t.Errorf("%s produced incorrect mean %v (expected %v), with z-score %v", t.Name(), sum/EXAMPLE_SAMPLES, dist.Mean(), z)
+	}
+}
+
+func TestNormal(t *testing.T) {
+	testExample(t, NewNormal(xoroshiro128plus.NewSource(1)), distuv.UnitNormal)
+}
+
+func TestGamma(t *testing.T) {
+	testExample(t, NewGamma(xoroshiro128plus.NewSource(1)), distuv.Gamma{Alpha: 2.5, Beta: 1})
+}
+
+func TestSmallGamma(t *testing.T) {
+	testExample(t, NewSmallGamma(xoroshiro128plus.NewSource(1)), distuv.Gamma{Alpha: 0.5, Beta: 1})
+}
+
+func TestTinyGamma(t *testing.T) {
+	testExample(t, NewTinyGamma(xoroshiro128plus.NewSource(1)), distuv.Gamma{Alpha: 0.05, Beta: 1})
+}
+
+func TestStudentsT(t *testing.T) {
+	testExample(t, NewStudentsT(xoroshiro128plus.NewSource(1)), distuv.StudentsT{Nu: 3, Mu: 0, Sigma: 1})
+}
+
+func TestTriangle(t *testing.T) {
+	testExample(t, NewTriangle(xoroshiro128plus.NewSource(1)), distuv.NewTriangle(0, 1, 1, nil))
+}
+
+func BenchmarkNormal(b *testing.B) {
+	b.Run("algorithm=Generated", func(b *testing.B) {
+		rng := NewNormal(xoroshiro128plus.NewSource(rand.Int64()))
+		for b.Loop() {
+			rng.Rand()
+		}
+	})
+	b.Run("algorithm=SymmetricZiggurat", func(b *testing.B) {
+		rng := ziggurat.ToSymmetricZiggurat(distuv.UnitNormal, xoroshiro128plus.NewSource(rand.Int64()))
+		for b.Loop() {
+			rng.Rand()
+		}
+	})
+	b.Run("algorithm=Stdlib", func(b *testing.B) {
+		rng := rand.New(xoroshiro128plus.NewSource(rand.Int64()))
+		for b.Loop() {
+			rng.NormFloat64()
+		}
+	})
+}
+
+func BenchmarkGamma(b *testing.B) {
+	b.Run("algorithm=Generated", func(b *testing.B) {
+		rng := NewGamma(xoroshiro128plus.NewSource(rand.Int64()))
+		for b.Loop() {
+			rng.Rand()
+		}
+	})
+	b.Run("algorithm=Ziggurat", func(b *testing.B) {
+		rng := ziggurat.ToZiggurat(distuv.Gamma{Alpha: 2.5, Beta: 1}, xoroshiro128plus.NewSource(rand.Int64()))
+		for b.Loop() {
+			rng.Rand()
+		}
+	})
+}
@@ -0,0 +1,285 @@
+// Code generated by "ziggen -a 0 -b 1 -c 1 -dist triangle -name Triangle -package example -strips 256"; DO NOT EDIT.
+
+package example
+
+import (
+	"math/rand/v2"
+
+	"gonum.org/v1/gonum/stat/distuv"
+)
+
+// Triangle samples from distuv.NewTriangle(0, 1, 1, nil), using a precomputed ziggurat of 256 strips.
+type Triangle struct {
+	src rand.Source
+}
+
+// NewTriangle returns a Triangle sampler which draws random bits from src.
+func NewTriangle(src rand.Source) *Triangle {
+	return &Triangle{src: src}
+}
+
+// Rand returns a random sample from the distribution.
+func (z *Triangle) Rand() float64 {
+	return triangleMode - triangleZiggurat.sample(z.src)
+}
+
+var triangleDistribution = distuv.NewTriangle(0, 1, 1, nil)
+
+const triangleMode = 1
+
+func triangleUniform(src rand.Source) float64 {
+	return float64(src.Uint64()>>11) / (1 << 53)
+}
+
+// One side of the mode of the distribution, sampled by a ziggurat with the mode at 0.
+type triangleSide struct {
+	widths, splits, tops       *[256]float64
+	infinitePeak, infiniteTail bool
+	sign, mass                 float64
+}
+
+var triangleZiggurat = &triangleSide{widths: &triangleZigguratWidths, splits: &triangleZigguratSplits, tops: &triangleZigguratTops, infinitePeak: false, infiniteTail: false, sign: -1, mass: 1}
+
+var triangleZigguratWidths = [256]float64{
+	1, 0.998044963916957, 0.9960860906568267, 0.9941233575366792,
+	0.9921567416492215, 0.9901862198596788, 0.9882117688026185, 0.9862333648787189,
+	0.9842509842514764, 0.9822646028438571, 0.9802741963348826, 0.978279740156158,
+	0.976281209488332, 0.9742785792574934, 0.9722718241315029, 0.9702609185162515,
+	0.9682458365518543, 0.9662265521087691, 0.9642030387838444, 0.9621752698962908,
+	0.960143218483576, 0.9581068572972432, 0.9560661587986472, 0.9540210951546093,
+	0.9519716382329885, 0.9499177595981665, 0.9478594305064438, 0.9457966219013473,
+	0.9437293044088436, 0.9416574483324601, 0.9395810236483068, 0.9375000000000002,
+	0.9354143466934854, 0.9333240326917551, 0.9312290266094589, 0.9291292967074066,
+	0.9270248108869578, 0.9249155366842964, 0.9228014412645876, 0.9206824914160147,
+	0.9185586535436918, 0.9164298936634488, 0.9142961773954872, 0.9121574699579015,
+	0.9100137361600649, 0.9078649403958721, 0.9057110466368398, 0.9035520184250599,
+	0.9013878188659973, 0.899218410621135, 0.8970437559004577, 0.8948638164547721,
+	0.8926785535678563, 0.8904879280484381, 0.8882919002219934, 0.8860904299223641,
+	0.8838834764831847, 0.8816709987291177, 0.8794529549668931, 0.8772293029761375,
+	0.8749999999999999, 0.8727650027355589, 0.8705242673240077, 0.8682777493406129,
+	0.8660254037844387, 0.8637671850678282, 0.8615030470056391, 0.8592329428042199,
+	0.8569568250501306, 0.854674645698584, 0.8523863560616161, 0.8500919067959652,
+	0.8477912478906585, 0.8454843286542928, 0.8431710977020026, 0.8408515029421071,
+	0.8385254915624211, 0.8361930100162283, 0.8338540040078958, 0.8315084184781296,
+	0.8291561975888502, 0.8267972847076844, 0.8244316223920575, 0.8220591523728691,
+	0.8196798155377503, 0.8172935519138764, 0.8149003006503313, 0.8124999999999999,
+	0.8100925873009824, 0.8076779989575054, 0.8052561704203204, 0.8028270361665706,
+	0.8003905296791063, 0.7979465834252316, 0.7954951288348661, 0.793036096278095,
+	0.7905694150420949, 0.7880950133074058, 0.7856128181235336, 0.7831227553838541,
+	0.7806247497997998, 0.7781187248742957, 0.7756046028744285, 0.7730823048033114,
+	0.7705517503711221, 0.7680128579652818, 0.7654655446197433, 0.7629097259833565,
+	0.7603453162872775, 0.7577722283113839, 0.7551903733496607, 0.7525996611745185,
+	0.7499999999999999, 0.7473912964438373, 0.7447734554883116, 0.74214638043987,
+	0.7395099728874521, 0.7368641326594746, 0.7342087577794206, 0.7315437444199765,
+	0.7288689868556626, 0.7261843774138906, 0.7234898064243891, 0.7207851621669248,
+	0.7180703308172535, 0.715345196391225, 0.7126096406869612, 0.7098635432250341,
+	0.7071067811865474, 0.7043392293490401, 0.701560760020114, 0.6987712429686843,
+	0.6959705453537527, 0.6931585316505886, 0.6903350635742037, 0.6874999999999999,
+	0.6846531968814576, 0.6817945071647322, 0.6789237807000135, 0.6760408641494979,
+	0.673145600891813, 0.6702378309227257, 0.667317390751957, 0.6643841132959156,
+	0.6614378277661477, 0.6584783595532964, 0.6555055301063447, 0.6525191568069094,
+	0.6495190528383289, 0.6465050270492875, 0.6434768838116874, 0.640434422872475,
+	0.6373774391990982, 0.6343057228182637, 0.6312190586476298, 0.6281172263200555,
+	0.6249999999999999, 0.6218671481916375, 0.6187184335382291, 0.6155536126122566,
+	0.6123724356957946, 0.6091746465505603, 0.6059599821770412, 0.6027281725620596,
+	0.59947894041409, 0.596212000885591, 0.5929270612815711, 0.5896238207535378,
+	0.5863019699779287, 0.582961190818051, 0.5796011559684814, 0.5762215285808054,
+	0.5728219618694801, 0.5694020986965186, 0.5659615711335885, 0.5624999999999999,
+	0.5590169943749473, 0.5555121510822242, 0.5519850541454904, 0.5484352742120076,
+	0.5448623679425844, 0.5412658773652742, 0.5376453291901642, 0.5340002340823456,
+	0.5303300858899107, 0.5266343608235224, 0.5229125165837972, 0.5191639914323798,
+	0.5153882032022076, 0.511584548242028, 0.5077524002897474, 0.5038911092686592,
+	0.4999999999999999, 0.49607837082461054, 0.49212549212573825, 0.48814060474416576,
+	0.4841229182759269, 0.480071609241788, 0.4759858191164942, 0.4718646522044218,
+	0.4677071733467428, 0.4635124054434788, 0.45927932677184574, 0.4550068680800325,
+	0.45069390943299864, 0.44633927678392815, 0.4419417382415921, 0.4374999999999999,
+	0.43301270189221935, 0.42847841252506524, 0.423895623945329, 0.41926274578121053,
+	0.4145780987944249, 0.4098399077688749, 0.4050462936504912, 0.4001952648395529,
+	0.39528470752104744, 0.39031237489989995, 0.3852758751855611, 0.3801726581436388,
+	0.3749999999999999, 0.3697549864437259, 0.36443449342783135, 0.3590351654086267,
+	0.3535533905932737, 0.34798527267687634, 0.34232659844072877, 0.3365728004459065,
+	0.3307189138830739, 0.3247595264191644, 0.31868871959954886, 0.3124999999999999,
+	0.30618621784789707, 0.29973947020704494, 0.29315098498896414, 0.2864109809347399,
+	0.2795084971874736, 0.27243118397129196, 0.2651650429449554, 0.25769410160110356,
+	0.2499999999999999, 0.24206145913796343, 0.23385358667337122, 0.22534695471649938,
+	0.2165063509461095, 0.20728904939721252, 0.19764235376052353, 0.1874999999999997,
+	0.17677669529663687, 0.16535945694153653, 0.15309310892394856, 0.13975424859373653,
+	0.12499999999999989, 0.10825317547305437, 0.08838834764831795, 0.062499999999999674,
+}
+
+var triangleZigguratSplits = [256]float64{
+	0.998044963916957, 0.9960860906568267, 0.9941233575366792, 0.9921567416492215,
+	0.9901862198596788, 0.9882117688026185, 0.9862333648787189, 0.9842509842514764,
+	0.9822646028438571, 0.9802741963348826, 0.978279740156158, 0.976281209488332,
+	0.9742785792574934, 0.9722718241315029, 0.9702609185162515, 0.9682458365518543,
+	0.9662265521087691, 0.9642030387838444, 0.9621752698962908, 0.960143218483576,
+	0.9581068572972432, 0.9560661587986472, 0.9540210951546093, 0.9519716382329885,
+	0.9499177595981665, 0.9478594305064438, 0.9457966219013473, 0.9437293044088436,
+	0.9416574483324601, 0.9395810236483068, 0.9375000000000002, 0.9354143466934854,
+	0.9333240326917551, 0.9312290266094589, 0.9291292967074066, 0.9270248108869578,
+	0.9249155366842964, 0.9228014412645876, 0.9206824914160147, 0.9185586535436918,
+	0.9164298936634488, 0.9142961773954872, 0.9121574699579015, 0.9100137361600649,
+	0.9078649403958721, 0.9057110466368398, 0.9035520184250599, 0.9013878188659973,
+	0.899218410621135, 0.8970437559004577, 0.8948638164547721, 0.8926785535678563,
+	0.8904879280484381, 0.8882919002219934, 0.8860904299223641, 0.8838834764831847,
+	0.8816709987291177, 0.8794529549668931, 0.8772293029761375, 0.8749999999999999,
+	0.8727650027355589, 0.8705242673240077, 0.8682777493406129, 0.8660254037844387,
+	0.8637671850678282, 0.8615030470056391, 0.8592329428042199, 0.8569568250501306,
+	0.854674645698584, 0.8523863560616161, 0.8500919067959652, 0.8477912478906585,
+	0.8454843286542928, 0.8431710977020026, 0.8408515029421071, 0.8385254915624211,
+	0.8361930100162283, 0.8338540040078958, 0.8315084184781296, 0.8291561975888502,
+	0.8267972847076844, 0.8244316223920575, 0.8220591523728691, 0.8196798155377503,
+	0.8172935519138764, 0.8149003006503313, 0.8124999999999999, 0.8100925873009824,
+	0.8076779989575054, 0.8052561704203204, 0.8028270361665706, 0.8003905296791063,
+	0.7979465834252316, 0.7954951288348661, 0.793036096278095, 0.7905694150420949,
+	0.7880950133074058, 0.7856128181235336, 0.7831227553838541, 0.7806247497997998,
+	0.7781187248742957, 0.7756046028744285, 0.7730823048033114, 0.7705517503711221,
+	0.7680128579652818, 0.7654655446197433, 0.7629097259833565, 0.7603453162872775,
+	0.7577722283113839, 0.7551903733496607, 0.7525996611745185, 0.7499999999999999,
+	0.7473912964438373, 0.7447734554883116, 0.74214638043987, 0.7395099728874521,
+	0.7368641326594746, 0.7342087577794206, 0.7315437444199765, 0.7288689868556626,
+	0.7261843774138906, 0.7234898064243891, 0.7207851621669248, 0.7180703308172535,
+	0.715345196391225, 0.7126096406869612, 0.7098635432250341, 0.7071067811865474,
+	0.7043392293490401, 0.701560760020114, 0.6987712429686843, 0.6959705453537527,
+	0.6931585316505886, 0.6903350635742037, 0.6874999999999999, 0.6846531968814576,
+	0.6817945071647322, 0.6789237807000135, 0.6760408641494979, 0.673145600891813,
+	0.6702378309227257, 0.667317390751957, 0.6643841132959156, 0.6614378277661477,
+	0.6584783595532964, 0.6555055301063447, 0.6525191568069094, 0.6495190528383289,
+	0.6465050270492875, 0.6434768838116874, 0.640434422872475, 0.6373774391990982,
+	0.6343057228182637, 0.6312190586476298, 0.6281172263200555, 0.6249999999999999,
+	0.6218671481916375, 0.6187184335382291, 0.6155536126122566, 0.6123724356957946,
+	0.6091746465505603, 0.6059599821770412, 0.6027281725620596, 0.59947894041409,
+	0.596212000885591, 0.5929270612815711, 0.5896238207535378, 0.5863019699779287,
+	0.582961190818051, 0.5796011559684814, 0.5762215285808054, 0.5728219618694801,
+	0.5694020986965186, 0.5659615711335885, 0.5624999999999999, 0.5590169943749473,
+	0.5555121510822242, 0.5519850541454904, 0.5484352742120076, 0.5448623679425844,
+	0.5412658773652742, 0.5376453291901642, 0.5340002340823456, 0.5303300858899107,
+	0.5266343608235224, 0.5229125165837972, 0.5191639914323798, 0.5153882032022076,
+	0.511584548242028, 0.5077524002897474, 0.5038911092686592, 0.4999999999999999,
+	0.49607837082461054, 0.49212549212573825, 0.48814060474416576, 0.4841229182759269,
+	0.480071609241788, 0.4759858191164942, 0.4718646522044218, 0.4677071733467428,
+	0.4635124054434788, 0.45927932677184574, 0.4550068680800325, 0.45069390943299864,
+	0.44633927678392815, 0.4419417382415921, 0.4374999999999999, 0.43301270189221935,
+	0.42847841252506524, 0.423895623945329, 0.41926274578121053, 0.4145780987944249,
+	0.4098399077688749, 0.4050462936504912, 0.4001952648395529, 0.39528470752104744,
+	0.39031237489989995, 0.3852758751855611, 0.3801726581436388, 0.3749999999999999,
+	0.3697549864437259, 0.36443449342783135, 0.3590351654086267, 0.3535533905932737,
+	0.34798527267687634, 0.34232659844072877, 0.3365728004459065, 0.3307189138830739,
+	0.3247595264191644, 0.31868871959954886, 0.3124999999999999, 0.30618621784789707,
+	0.29973947020704494, 0.29315098498896414, 0.2864109809347399, 0.2795084971874736,
+	0.27243118397129196, 0.2651650429449554, 0.25769410160110356, 0.2499999999999999,
+	0.24206145913796343, 0.23385358667337122, 0.22534695471649938, 0.2165063509461095,
+	0.20728904939721252, 0.19764235376052353, 0.1874999999999997, 0.17677669529663687,
+	0.16535945694153653, 0.15309310892394856, 0.13975424859373653, 0.12499999999999989,
+	0.10825317547305437, 0.08838834764831795, 0.062499999999999674, 0,
+}
+
+var triangleZigguratTops = [256]float64{
+	0.003910072166086035, 0.007827818686346525, 0.011753284926641672, 0.01568651670155704,
+	0.01962756028064252, 0.02357646239476287, 0.027533270242562514, 0.031498031497047274,
+	0.03547079431228608, 0.039451607330234735, 0.043440519687684, 0.0474375810233364,
+	0.0514428414850131, 0.055456351736994355, 0.0594781629674971, 0.06350832689629152,
+	0.06754689578246173, 0.07159392243231101, 0.07564946020741842, 0.07971356303284799,
+	0.0837862854055135, 0.08786768240270566, 0.0919578096907815, 0.09605672353402292,
+	0.10016448080366706, 0.10428113898711239, 0.10840675619730558, 0.11254139118231261,
+	0.11668510333507957, 0.12083795270338644, 0.12499999999999997, 0.12917130661302925,
+	0.1333519346164902, 0.13754194678108247, 0.14174140658518689, 0.14595037822608428,
+	0.15016892663140724, 0.15439711747082496, 0.1586350171679706, 0.16288269291261648,
+	0.16714021267310253, 0.17140764520902577, 0.17568506008419704, 0.1799725276798705,
+	0.18427011920825626, 0.18857790672632027, 0.19289596314987995, 0.19722436226800538,
+	0.20156317875773022, 0.2059124881990846, 0.21027236709045588, 0.21464289286428748,
+	0.21902414390312408, 0.22341619955601313, 0.22781914015527188, 0.23223304703363115,
+	0.23665800254176453, 0.24109409006621396, 0.24554139404772507, 0.25000000000000006,
+	0.2544699945288824, 0.2589514653519851, 0.26344450131877445, 0.2679491924311227,
+	0.2724656298643434, 0.2769939059887223, 0.28153411439156006, 0.286086349899739,
+	0.29065070860283215, 0.295227287876768, 0.2998161864080697, 0.30441750421868297,
+	0.3090313426914146, 0.31365780459599485, 0.3182969941157862, 0.3229490168751577,
+	0.3276139799675435, 0.3322919919842084, 0.33698316304374115, 0.3416876048223001,
+	0.3464054305846309, 0.35113675521588517, 0.35588169525426183, 0.3606403689244998,
+	0.3654128961722474, 0.3701993986993379, 0.37500000000000006, 0.379814825398035,
+	0.38464400208498933, 0.3894876591593594, 0.3943459276668589, 0.3992189406417878,
+	0.40410683314953694, 0.4090097423302681, 0.4139278074438101, 0.4188611699158104,
+	0.4238099733851885, 0.42877436375293315, 0.4337544892322916, 0.4387505004004005,
+	0.44376255025140854, 0.44879079425114304, 0.4538353903933774, 0.45889649925775583,
+	0.4639742840694365, 0.4690689107605137, 0.47418054803328724, 0.47930936742544505,
+	0.4844555433772324, 0.48961925330067857, 0.4948006776509631, 0.5000000000000001,
+	0.5052174071123253, 0.5104530890233769, 0.5157072391202603, 0.520980054225096,
+	0.5262717346810506, 0.5315824844411587, 0.536912511160047, 0.5422620262886749,
+	0.5476312451722187, 0.5530203871512218, 0.5584296756661504, 0.563859338365493,
+	0.5693096072175503, 0.5747807186260776, 0.5802729135499317, 0.5857864376269051,
+	0.5913215413019195, 0.5968784799597721, 0.6024575140626315, 0.6080589092924946,
+	0.6136829366988229, 0.6193298728515926, 0.6250000000000001, 0.6306936062370847,
+	0.6364109856705357, 0.6421524385999731, 0.6479182717010041, 0.6537087982163741,
+	0.659524338154549, 0.6653652184960861, 0.6712317734081689, 0.6771243444677048,
+	0.6830432808934077, 0.6889889397873107, 0.6949616863861813, 0.7009618943233422,
+	0.706989945901425, 0.7130462323766251, 0.7191311542550503, 0.7252451216018039,
+	0.7313885543634727, 0.7375618827047402, 0.7437655473598888, 0.7500000000000001,
+	0.7562657036167252, 0.762563132923542, 0.768892774775487, 0.7752551286084111,
+	0.7816507068988795, 0.7880800356459178, 0.7945436548758807, 0.8010421191718202,
+	0.8075759982288181, 0.8141458774368577, 0.8207523584929245, 0.8273960600441427,
+	0.8340776183638982, 0.8407976880630372, 0.8475569428383891, 0.8543560762610399,
+	0.8611958026069628, 0.8680768577328232, 0.8750000000000002, 0.8819660112501054,
+	0.8889756978355515, 0.8960298917090195, 0.9031294515759849, 0.9102752641148315,
+	0.9174682452694518, 0.9247093416196717, 0.9319995318353089, 0.9393398282201788,
+	0.9467312783529552, 0.9541749668324058, 0.9616720171352406, 0.9692235935955851,
+	0.9768309035159439, 0.9844951994205052, 0.9922177814626815, 1.0000000000000002,
+	1.0078432583507788, 1.0157490157485238, 1.0237187905116685, 1.031754163448146,
+	1.0398567815164241, 1.0480283617670119, 1.0562706955911565, 1.0645856533065146,
+	1.0729751891130423, 1.0814413464563084, 1.0899862638399351, 1.0986121811340028,
+	1.107321446432144, 1.116116523516816, 1.1250000000000002, 1.1339745962155616,
+	1.1430431749498697, 1.152208752109342, 1.161474508437579, 1.1708438024111503,
+	1.1803201844622502, 1.1899074126990177, 1.1996094703208942, 1.2094305849579055,
+	1.2193752502002004, 1.229448249628878, 1.2396546837127227, 1.2500000000000004,
+	1.260490027112548, 1.2711310131443376, 1.2819296691827469, 1.2928932188134525,
+	1.3040294546462474, 1.315346803118543, 1.3268543991081874, 1.3385621722338525,
+	1.3504809471616714, 1.3626225608009022, 1.3750000000000004, 1.3876275643042058,
+	1.4005210595859103, 1.4136980300220716, 1.4271780381305201, 1.440983005625053,
+	1.4551376320574163, 1.4696699141100895, 1.4846117967977928, 1.5000000000000004,
+	1.5158770817240734, 1.5322928266532578, 1.5493060905670017, 1.566987298107781,
+	1.5854219012055752, 1.6047152924789532, 1.6250000000000009, 1.6464466094067265,
+	1.6692810861169272, 1.693813782152103, 1.7204915028125272, 1.7500000000000004,
+	1.7834936490538915, 1.8232233047033644, 1.8750000000000009, 2,
+}
+
+func (s *triangleSide) prob(x float64) float64 {
+	return triangleDistribution.Prob(triangleMode+s.sign*x) / s.mass
+}
+
+func (s *triangleSide) quantile(p float64) float64 {
+	if s.sign < 0 {
+		return triangleMode - triangleDistribution.Quantile((1-p)*s.mass)
+	}
+	return triangleDistribution.Quantile(1-(1-p)*s.mass) - triangleMode
+}
+
+func (s *triangleSide) sample(src rand.Source) float64 {
+	r := src.Uint64()
+	i := r & 255
+	x := float64(r>>11) / (1 << 53)
+	if y := x * s.widths[i]; y < s.splits[i] {
+		return y
+	}
+	return s.slow(src, i, x)
+}
+
+// Sample from strip i, starting from x uniform in [0, 1).
+func (s *triangleSide) slow(src rand.Source, i uint64, x float64) float64 {
+	for {
+		x *= s.widths[i]
+		if x < s.splits[i] {
+			return x
+		}
+		top := s.tops[i]
+		if i == 0 && s.infiniteTail {
+			return s.quantile(1 - (s.widths[0]-x)*top)
+		}
+		bottom := 0.0
+		if i > 0 {
+			bottom = s.tops[i-1]
+		}
+		if triangleUniform(src) < (s.prob(x)-bottom)/(top-bottom) {
+			return x
+		}
+		x = triangleUniform(src)
+	}
+}
@@ -0,0 +1,422 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"go/token"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"text/template"
+	"unicode"
+
+	"github.com/argusdusty/ziggurat"
+	"github.com/argusdusty/ziggurat/internal/catalog"
+)
+
+type config struct {
+	Args    string // The command line arguments, recorded in the generated file.
+	Package string
+	Type    string
+	Entry   *catalog.Entry
+	Params  []float64
+	Strips  int
+	Tail    ziggurat.Tail
+}
+
+// One side of the mode of the distribution, sampled by a single ziggurat.
+type side struct {
+	Name                       string
+	Widths, Splits, Tops       []float64 // The width of each strip is the split of the strip below it, or the tail width for strip 0.
+	InfinitePeak, InfiniteTail bool
+	Sign                       float64 // The direction of the side from the mode.
+	Mass                       float64 // The probability of the side.
+	TailExponential            bool    // Whether the tail envelope is exponential, rather than Pareto, if TailRate is nonzero.
+	TailRate                   float64
+	PeakStrip                  int
+	Top                        int // The strip of the peak, which the strips above share where their tops overflow.
+	PeakExponent, PeakLogScale float64
+	PeakLogXMin                float64
+	PeakLogDensity, PeakSlope  float64
+}
+
+func generate(c config) ([]byte, error) {
+	if !token.IsIdentifier(c.Type) || !token.IsExported(c.Type) {
+		return nil, fmt.Errorf("invalid type name %q", c.Type)
+	}
+	if !token.IsIdentifier(c.Package) {
+		return nil, fmt.Errorf("invalid package name %q", c.Package)
+	}
+	dist := c.Entry.New(c.Params)
+	symmetric := c.Entry.Symmetric(c.Params)
+	var s ziggurat.Sampler
+	var err error
+	if symmetric {
+		s, err = ziggurat.NewSymmetricZiggurat(dist, nil, ziggurat.Options{Strips: c.Strips, Tail: c.Tail})
+	} else {
+		s, err = ziggurat.NewZiggurat(dist, nil, ziggurat.Options{Strips: c.Strips, Tail: c.Tail})
+	}
+	if err != nil {
+		return nil, err
+	}
+	root, err := ziggurat.TablesOf(s)
+	if err != nil {
+		return nil, err
+	}
+
+	prefix := string(unicode.ToLower(rune(c.Type[0]))) + c.Type[1:]
+	g := struct {
+		config
+		Prefix    string
+		Expr      string
+		Mode      string
+		Mask      int
+		Symmetric bool
+		RightProb string
+		Sides     []side
+		UsesMath  bool
+		LogProb   bool // Whether the distribution has a LogProb method, for the envelopes.
+		// Which ways of sampling the tail and peak any side uses.
+		TailEnvelope, QuantileTail bool
+		PeakEnvelope, QuantilePeak bool
+		SharedPeak                 bool // Whether any side has strips above its top, sharing the peak.
+	}{config: c, Prefix: prefix, Expr: c.Entry.GoExpr(c.Params), Mode: formatFloat(dist.Mode()), Symmetric: symmetric}
+	switch root.Kind {
+	case ziggurat.TablesSymmetric:
+		g.Sides = []side{newSide(prefix+"Ziggurat", root, 1, dist.Survival(dist.Mode()))}
+	case ziggurat.TablesZiggurat:
+		g.Sides = []side{newSide(prefix+"Ziggurat", root, 1, 1)}
+	case ziggurat.TablesFlipped:
+		g.Sides = []side{newSide(prefix+"Ziggurat", root.Parts[0], -1, 1)}
+	case ziggurat.TablesTwoPart:
+		rightProb := root.RightSideProb
+		left, right := root.Parts[0], root.Parts[1]
+		leftSign, rightSign := 1.0, 1.0
+		if left.Kind == ziggurat.TablesFlipped {
+			left, leftSign = left.Parts[0], -1
+		}
+		if right.Kind == ziggurat.TablesFlipped {
+			right, rightSign = right.Parts[0], -1
+		}
+		g.RightProb = formatFloat(rightProb)
+		g.Sides = []side{newSide(prefix+"Left", left, leftSign, 1-rightProb), newSide(prefix+"Right", right, rightSign, rightProb)}
+	default:
+		return nil, fmt.Errorf("unexpected tables of kind %v", root.Kind)
+	}
+	g.Mask = len(g.Sides[0].Splits) - 1
+	_, g.LogProb = dist.(interface{ LogProb(x float64) float64 })
+	for _, s := range g.Sides {
+		g.TailEnvelope = g.TailEnvelope || s.TailRate != 0
+		g.QuantileTail = g.QuantileTail || (s.InfiniteTail && s.TailRate == 0)
+		g.PeakEnvelope = g.PeakEnvelope || s.PeakExponent != 0
+		g.QuantilePeak = g.QuantilePeak || (s.InfinitePeak && s.PeakExponent == 0)
+		g.SharedPeak = g.SharedPeak || s.Top < g.Mask
+	}
+	// Without any tail envelope, the tail is sampled with the quantile, as before, even if no side has an infinite tail.
+	g.QuantileTail = g.QuantileTail || !g.TailEnvelope
+	g.UsesMath = symmetric || g.TailEnvelope || g.PeakEnvelope || g.QuantilePeak
+
+	var buf bytes.Buffer
+	if err := sourceTemplate.Execute(&buf, g); err != nil {
+		return nil, err
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting generated source: %w", err)
+	}
+	return src, nil
+}
+
+func newSide(name string, t *ziggurat.Tables, sign, mass float64) side {
+	s := side{Name: name, InfinitePeak: t.HasInfinitePeak, InfiniteTail: t.HasInfiniteTail, Sign: sign, Mass: mass}
+	if t.Tail != ziggurat.TailQuantile {
+		s.TailExponential, s.TailRate = t.Tail == ziggurat.TailExponential, t.TailRate
+	}
+	s.PeakStrip, s.PeakExponent, s.PeakLogScale, s.PeakLogXMin, s.PeakLogDensity, s.PeakSlope = t.PeakStrip, t.PeakExponent, t.PeakLogScale, t.PeakLogXMin, t.PeakLogDensity, t.PeakSlope
+	s.Splits, s.Tops = t.StripSplits, t.StripTops
+	s.Top = slices.Index(t.StripSplits, 0)
+	s.Widths = append([]float64{t.TailPrevSplit}, t.StripSplits[:len(t.StripSplits)-1]...)
+	return s
+}
+
+func formatFloat(x float64) string {
+	if math.IsInf(x, 0) {
+		return fmt.Sprintf("math.Inf(%d)", int(math.Copysign(1, x)))
+	}
+	return strconv.FormatFloat(x, 'g', -1, 64)
+}
+
+func formatTable(xs []float64) string {
+	var b strings.Builder
+	for i, x := range xs {
+		if i%4 == 0 {
+			b.WriteString("\n\t")
+		} else {
+			b.WriteString(" ")
+		}
+		b.WriteString(formatFloat(x))
+		b.WriteString(",")
+	}
+	b.WriteString("\n")
+	return b.String()
+}
+
+var sourceTemplate = template.Must(template.New("").Funcs(template.FuncMap{"float": formatFloat, "table": formatTable}).Parse(`// Code generated by "ziggen {{.Args}}"; DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+{{- if .UsesMath}}
+	"math"
+{{- end}}
+	"math/rand/v2"
+
+	"gonum.org/v1/gonum/stat/distuv"
+)
+
+// {{.Type}} samples from {{.Expr}}, using a precomputed ziggurat of {{len (index .Sides 0).Splits}} strips.
+type {{.Type}} struct {
+	src rand.Source
+}
+
+// New{{.Type}} returns a {{.Type}} sampler which draws random bits from src.
+func New{{.Type}}(src rand.Source) *{{.Type}} {
+	return &{{.Type}}{src: src}
+}
+
+// Rand returns a random sample from the distribution.
+func (z *{{.Type}}) Rand() float64 {
+{{- if .RightProb}}
+	if {{.Prefix}}Uniform(z.src) < {{.RightProb}} {
+		return {{.Prefix}}Mode {{if lt (index .Sides 1).Sign 0.0}}-{{else}}+{{end}} {{(index .Sides 1).Name}}.sample(z.src)
+	}
+	return {{.Prefix}}Mode {{if lt (index .Sides 0).Sign 0.0}}-{{else}}+{{end}} {{(index .Sides 0).Name}}.sample(z.src)
+{{- else if .Symmetric}}
+	return {{.Prefix}}Mode + {{(index .Sides 0).Name}}.sampleSymmetric(z.src)
+{{- else}}
+	return {{.Prefix}}Mode {{if lt (index .Sides 0).Sign 0.0}}-{{else}}+{{end}} {{(index .Sides 0).Name}}.sample(z.src)
+{{- end}}
+}
+
+var {{.Prefix}}Distribution = {{.Expr}}
+
+const {{.Prefix}}Mode = {{.Mode}}
+
+func {{.Prefix}}Uniform(src rand.Source) float64 {
+	return float64(src.Uint64()>>11) / (1 << 53)
+}
+
+// One side of the mode of the distribution, sampled by a ziggurat with the mode at 0.
+type {{.Prefix}}Side struct {
+	widths, splits, tops       *[{{len (index .Sides 0).Splits}}]float64
+	infinitePeak, infiniteTail bool
+	sign, mass                 float64
+{{- if .TailEnvelope}}
+	// The infinite tail is sampled by rejection from an exponential, or otherwise Pareto, envelope, if tailRate is nonzero.
+	tailExponential bool
+	tailRate        float64
+{{- end}}
+{{- if .PeakEnvelope}}
+	// The wedges of the infinite peak from strip peakStrip upwards are sampled by rejection from the envelope
+	// exp(peakLogScale)*x^-peakExponent, if peakExponent is nonzero. Below exp(peakLogXMin), the log density is
+	// extrapolated from peakLogDensity with slope peakSlope against log x.
+	peakStrip                                                         uint64
+	peakExponent, peakLogScale, peakLogXMin, peakLogDensity, peakSlope float64
+{{- end}}
+{{- if .SharedPeak}}
+	// The strip of the peak, which the strips above share where their tops overflow.
+	top uint64
+{{- end}}
+}
+
+{{- range .Sides}}
+
+var {{.Name}} = &{{$.Prefix}}Side{widths: &{{.Name}}Widths, splits: &{{.Name}}Splits, tops: &{{.Name}}Tops, infinitePeak: {{.InfinitePeak}}, infiniteTail: {{.InfiniteTail}}, sign: {{float .Sign}}, mass: {{float .Mass}}
+{{- if $.TailEnvelope}}, tailExponential: {{.TailExponential}}, tailRate: {{float .TailRate}}{{end}}
+{{- if $.PeakEnvelope}}, peakStrip: {{.PeakStrip}}, peakExponent: {{float .PeakExponent}}, peakLogScale: {{float .PeakLogScale}}, peakLogXMin: {{float .PeakLogXMin}}, peakLogDensity: {{float .PeakLogDensity}}, peakSlope: {{float .PeakSlope}}{{end}}
+{{- if $.SharedPeak}}, top: {{.Top}}{{end}}}
+
+var {{.Name}}Widths = [{{len .Widths}}]float64{ {{- table .Widths -}} }
+
+var {{.Name}}Splits = [{{len .Splits}}]float64{ {{- table .Splits -}} }
+
+var {{.Name}}Tops = [{{len .Tops}}]float64{ {{- table .Tops -}} }
+{{- end}}
+
+func (s *{{.Prefix}}Side) prob(x float64) float64 {
+	return {{.Prefix}}Distribution.Prob({{.Prefix}}Mode+s.sign*x) / s.mass
+}
+
+
+{{- if or .TailEnvelope .PeakEnvelope}}
+
+func (s *{{.Prefix}}Side) logProb(x float64) float64 {
+{{- if .LogProb}}
+	return {{.Prefix}}Distribution.LogProb({{.Prefix}}Mode+s.sign*x) - math.Log(s.mass)
+{{- else}}
+	return math.Log({{.Prefix}}Distribution.Prob({{.Prefix}}Mode+s.sign*x)) - math.Log(s.mass)
+{{- end}}
+}
+{{- end}}
+{{- if .QuantilePeak}}
+
+func (s *{{.Prefix}}Side) survival(x float64) float64 {
+	if s.sign < 0 {
+		return (1 - {{.Prefix}}Distribution.Survival({{.Prefix}}Mode-x)) / s.mass
+	}
+	return {{.Prefix}}Distribution.Survival({{.Prefix}}Mode+x) / s.mass
+}
+{{- end}}
+{{- if or .QuantileTail .QuantilePeak}}
+
+func (s *{{.Prefix}}Side) quantile(p float64) float64 {
+	if s.sign < 0 {
+		return {{.Prefix}}Mode - {{.Prefix}}Distribution.Quantile((1-p)*s.mass)
+	}
+	return {{.Prefix}}Distribution.Quantile(1-(1-p)*s.mass) - {{.Prefix}}Mode
+}
+{{- end}}
+{{- if .Symmetric}}
+
+func (s *{{.Prefix}}Side) sampleSymmetric(src rand.Source) float64 {
+	r := src.Uint64()
+	i := r & {{.Mask}}
+	x := float64(int64(r)>>10) / (1 << 53)
+	if y := x * s.widths[i]; math.Abs(y) < s.splits[i] {
+		return y
+	}
+	if x < 0 {
+		return -s.slow(src, i, -x)
+	}
+	return s.slow(src, i, x)
+}
+{{- else}}
+
+func (s *{{.Prefix}}Side) sample(src rand.Source) float64 {
+	r := src.Uint64()
+	i := r & {{.Mask}}
+	x := float64(r>>11) / (1 << 53)
+	if y := x * s.widths[i]; y < s.splits[i] {
+		return y
+	}
+	return s.slow(src, i, x)
+}
+{{- end}}
+
+// Sample from strip i, starting from x uniform in [0, 1).
+func (s *{{.Prefix}}Side) slow(src rand.Source, i uint64, x float64) float64 {
+{{- if .SharedPeak}}
+	i = min(i, s.top)
+{{- end}}
+	for {
+		x *= s.widths[i]
+		if x < s.splits[i] {
+			return x
+		}
+{{- if .PeakEnvelope}}
+		if s.peakExponent != 0 && i >= s.peakStrip {
+			return s.samplePeak(src, s.splits[i], s.widths[i], s.tops[i-1], s.tops[i])
+		}
+{{- end}}
+		top := s.tops[i]
+		if i == 0 && s.infiniteTail {
+{{- if and .TailEnvelope .QuantileTail}}
+			if s.tailRate != 0 {
+				return s.sampleTail(src)
+			}
+			return s.quantile(1 - (s.widths[0]-x)*top)
+{{- else if .TailEnvelope}}
+			return s.sampleTail(src)
+{{- else}}
+			return s.quantile(1 - (s.widths[0]-x)*top)
+{{- end}}
+		}
+{{- if .QuantilePeak}}
+		if i == {{if .SharedPeak}}s.top{{else}}{{.Mask}}{{end}} && s.infinitePeak {
+			for {
+				y := s.quantile((s.survival(0) - s.survival(s.widths[i])) * {{.Prefix}}Uniform(src))
+				if {{.Prefix}}Uniform(src) > s.tops[i-1]/s.prob(y) {
+					return y
+				}
+			}
+		}
+{{- end}}
+		bottom := 0.0
+		if i > 0 {
+			bottom = s.tops[i-1]
+		}
+		if {{.Prefix}}Uniform(src) < (s.prob(x)-bottom)/(top-bottom) {
+			return x
+		}
+		x = {{.Prefix}}Uniform(src)
+	}
+}
+{{- if .TailEnvelope}}
+
+// Sample from the tail beyond the base strip, by rejection from its envelope.
+func (s *{{.Prefix}}Side) sampleTail(src rand.Source) float64 {
+	x0 := s.splits[0]
+	logTop := s.logProb(x0)
+	for {
+		var x, logEnvelope float64
+		if s.tailExponential {
+			x = x0 - math.Log1p(-{{.Prefix}}Uniform(src))/s.tailRate
+			logEnvelope = logTop - s.tailRate*(x-x0)
+		} else {
+			x = x0 * math.Pow(1-{{.Prefix}}Uniform(src), -1/(s.tailRate-1))
+			logEnvelope = logTop - s.tailRate*math.Log(x/x0)
+		}
+		if math.Log({{.Prefix}}Uniform(src)) < s.logProb(x)-logEnvelope {
+			return x
+		}
+	}
+}
+{{- end}}
+{{- if .PeakEnvelope}}
+
+// Sample from a strip of the infinite peak between the splits lo and hi, and the densities bottom and top, where lo is 0
+// and top is infinite for the top strip.
+func (s *{{.Prefix}}Side) samplePeak(src rand.Source, lo, hi, bottom, top float64) float64 {
+	if lo > 0 {
+		// Having failed to sample the rectangle under the density with probability lo/hi, choose it again so that it
+		// is chosen in proportion to its area overall.
+		q, r := lo/hi, float64(len(s.splits))*lo*(top-bottom)
+		if {{.Prefix}}Uniform(src) < (r-q)/(1-q) {
+			return lo * {{.Prefix}}Uniform(src)
+		}
+	}
+	// Sample the wedge by rejection from the envelope between lo and hi.
+	b := 1 - s.peakExponent
+	logLo, logHi, logBottom := math.Log(lo), math.Log(hi), math.Log(bottom)
+	for {
+		u := {{.Prefix}}Uniform(src)
+		var lx float64
+		if lo > 0 {
+			lx = logLo + math.Log1p(u*math.Expm1(b*(logHi-logLo)))/b
+		} else {
+			if u == 0 {
+				continue
+			}
+			lx = logHi + math.Log(u)/b
+		}
+		l := s.peakLogProb(lx)
+		if l <= logBottom {
+			continue
+		}
+		if math.Log({{.Prefix}}Uniform(src)) < l+math.Log1p(-math.Exp(logBottom-l))-(s.peakLogScale-s.peakExponent*lx) {
+			return math.Exp(lx)
+		}
+	}
+}
+
+// The log density at x = exp(lx).
+func (s *{{.Prefix}}Side) peakLogProb(lx float64) float64 {
+	if lx < s.peakLogXMin {
+		return s.peakLogDensity + s.peakSlope*(lx-s.peakLogXMin)
+	}
+	return s.logProb(math.Exp(lx))
+}
+{{- end}}
+`))
@@ -0,0 +1,99 @@
+// Ziggen generates Go source for a sampler from a fixed distribution, with its ziggurat tables precomputed.
+//
+// Usage:
+//
+//	ziggen -dist name [-param value ...] [-name Type] [-package pkg] [-strips n] [-tail method] [-o file]
+//
+// For example, to generate a sampler for the Gamma(2.5, 1) distribution:
+//
+//	ziggen -dist gamma -alpha 2.5 -name Gamma -package sim -o gamma.go
+//
+// The generated sampler has no construction cost, and avoids the interface calls of a Sampler built at runtime.
+// It depends only on gonum, which is still used for the rare samples from the tail and peak of the ziggurat.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/argusdusty/ziggurat"
+	"github.com/argusdusty/ziggurat/internal/catalog"
+)
+
+func main() {
+	if err := run(os.Args[1:]); err != nil {
+		fmt.Fprintln(os.Stderr, "ziggen:", err)
+		os.Exit(1)
+	}
+}
+
+func run(args []string) error {
+	fs := flag.NewFlagSet("ziggen", flag.ContinueOnError)
+	dist := fs.String("dist", "", "distribution to generate a sampler for, one of: "+strings.Join(catalog.Names(), ", "))
+	name := fs.String("name", "", "name of the generated sampler type (default: the capitalized distribution name)")
+	pkg := fs.String("package", "main", "package of the generated file")
+	strips := fs.Int("strips", ziggurat.ZIGGURAT_N, "number of strips in the ziggurat, a power of two")
+	tail := fs.String("tail", ziggurat.TailQuantile.String(), "how to sample an infinite tail, one of: "+strings.Join(tailNames(), ", "))
+	out := fs.String("o", "", "output file (default: stdout)")
+	params := catalog.RegisterFlags(fs)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() > 0 {
+		return fmt.Errorf("unexpected arguments: %s", strings.Join(fs.Args(), " "))
+	}
+	entry, err := catalog.Lookup(*dist)
+	if err != nil {
+		return err
+	}
+	p, err := params.Params(entry)
+	if err != nil {
+		return err
+	}
+	tailKind, err := parseTail(*tail)
+	if err != nil {
+		return err
+	}
+	if *name == "" {
+		*name = strings.ToUpper((*dist)[:1]) + (*dist)[1:]
+	}
+	src, err := generate(config{Args: recordedArgs(fs), Package: *pkg, Type: *name, Entry: entry, Params: p, Strips: *strips, Tail: tailKind})
+	if err != nil {
+		return err
+	}
+	if *out == "" {
+		_, err = os.Stdout.Write(src)
+		return err
+	}
+	return os.WriteFile(*out, src, 0o666)
+}
+
+// The flags to record in the generated file, omitting the output file so the result doesn't depend on where it's written.
+func recordedArgs(fs *flag.FlagSet) string {
+	var args []string
+	fs.Visit(func(f *flag.Flag) {
+		if f.Name != "o" {
+			args = append(args, "-"+f.Name, f.Value.String())
+		}
+	})
+	return strings.Join(args, " ")
+}
+
+func tailNames() []string {
+	var names []string
+	for t := ziggurat.TailQuantile; t <= ziggurat.TailPareto; t++ {
+		names = append(names, t.String())
+	}
+	return names
+}
+
+func parseTail(name string) (ziggurat.Tail, error) {
+	for t := ziggurat.TailQuantile; t <= ziggurat.TailPareto; t++ {
+		if t.String() == name {
+			return t, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown tail method %q, want one of: %s", name, strings.Join(tailNames(), ", "))
+}
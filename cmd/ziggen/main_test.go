@@ -0,0 +1,127 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/argusdusty/ziggurat"
+	"github.com/argusdusty/ziggurat/internal/catalog"
+)
+
+// The generated examples must match the output of the current generator.
+func TestExamplesUpToDate(t *testing.T) {
+	f, err := os.Open(filepath.Join("example", "doc.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	const directive = "//go:generate go run .. "
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line, ok := strings.CutPrefix(scanner.Text(), directive)
+		if !ok {
+			continue
+		}
+		args := strings.Fields(line)
+		out := args[len(args)-1]
+		t.Run(out, func(t *testing.T) {
+			tmp := filepath.Join(t.TempDir(), out)
+			if err := run(append(args[:len(args)-1:len(args)-1], tmp)); err != nil {
+				t.Fatal(err)
+			}
+			got, err := os.ReadFile(tmp)
+			if err != nil {
+				t.Fatal(err)
+			}
+			want, err := os.ReadFile(filepath.Join("example", out))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(got, want) {
+				t.Errorf("example/%s is out of date, run go generate ./cmd/ziggen/example", out)
+			}
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+var TEST_PARAMS = map[string][]float64{
+	"beta":        {2, 5},
+	"chisquared":  {3},
+	"exponential": {1},
+	"gamma":       {0.5, 1},
+	"gumbel":      {0, 1},
+	"laplace":     {0, 1},
+	"logistic":    {0, 1},
+	"normal":      {0, 1},
+	"studentst":   {5, 0, 1},
+	"triangle":    {0, 3, 1},
+	"weibull":     {2, 1},
+}
+
+// Every distribution in the catalog must generate valid Go source.
+func TestGenerate(t *testing.T) {
+	for _, name := range catalog.Names() {
+		t.Run(name, func(t *testing.T) {
+			entry, err := catalog.Lookup(name)
+			if err != nil {
+				t.Fatal(err)
+			}
+			params, ok := TEST_PARAMS[name]
+			if !ok {
+				t.Fatalf("No test parameters for %s", name)
+			}
+			for _, tail := range []ziggurat.Tail{ziggurat.TailQuantile, ziggurat.TailAuto} {
+				src, err := generate(config{Args: "-dist " + name, Package: "test", Type: "Sampler", Entry: entry, Params: params, Strips: 64, Tail: tail})
+				if err != nil {
+					t.Fatal(err)
+				}
+				if _, err := parser.ParseFile(token.NewFileSet(), name+".go", src, parser.AllErrors); err != nil {
+					t.Errorf("Generated source with %v tail does not parse: %v", tail, err)
+				}
+			}
+		})
+	}
+}
+
+// Where the splits underflow below the peak, as for Gamma with alpha=1e-3, the strips above the top share its peak.
+func TestGenerateSharedPeak(t *testing.T) {
+	entry, err := catalog.Lookup("gamma")
+	if err != nil {
+		t.Fatal(err)
+	}
+	src, err := generate(config{Args: "-dist gamma -alpha 0.001", Package: "test", Type: "Sampler", Entry: entry, Params: []float64{0.001, 1}, Strips: 256})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := parser.ParseFile(token.NewFileSet(), "gamma.go", src, parser.AllErrors); err != nil {
+		t.Errorf("Generated source does not parse: %v", err)
+	}
+	if !bytes.Contains(src, []byte("i = min(i, s.top)")) {
+		t.Errorf("Generated source does not share the peak between the strips above the top")
+	}
+}
+
+func TestRunErrors(t *testing.T) {
+	for _, args := range [][]string{
+		{"-dist", "cauchy"},
+		{"-dist", "gamma"},
+		{"-dist", "normal", "-alpha", "2"},
+		{"-dist", "normal", "-name", "lowercase"},
+		{"-dist", "normal", "-strips", "3"},
+		{"-dist", "normal", "-tail", "gaussian"},
+		{"-dist", "normal", "extra"},
+	} {
+		if err := run(args); err == nil {
+			t.Errorf("run(%q) did not return an error", args)
+		}
+	}
+}
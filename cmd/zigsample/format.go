@@ -0,0 +1,92 @@
+package main
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/argusdusty/ziggurat"
+)
+
+// A formatFunc writes n samples from s to w.
+type formatFunc func(w *bufio.Writer, s ziggurat.Sampler, n int) error
+
+var formats = []struct {
+	Name  string
+	Write formatFunc
+}{
+	{"text", writeText},
+	{"csv", writeCSV},
+	{"raw", writeRaw},
+	{"npy", writeNPY},
+}
+
+func lookupFormat(name string) (formatFunc, error) {
+	for _, f := range formats {
+		if f.Name == name {
+			return f.Write, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown format %q, expected one of: %s", name, strings.Join(formatNames(), ", "))
+}
+
+func formatNames() []string {
+	var names []string
+	for _, f := range formats {
+		names = append(names, f.Name)
+	}
+	return names
+}
+
+func writeText(w *bufio.Writer, s ziggurat.Sampler, n int) error {
+	var buf []byte
+	for x := range s.Samples(n) {
+		buf = strconv.AppendFloat(buf[:0], x, 'g', -1, 64)
+		buf = append(buf, '\n')
+		if _, err := w.Write(buf); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeCSV(w *bufio.Writer, s ziggurat.Sampler, n int) error {
+	if _, err := w.WriteString("x\n"); err != nil {
+		return err
+	}
+	return writeText(w, s, n)
+}
+
+func writeRaw(w *bufio.Writer, s ziggurat.Sampler, n int) error {
+	var buf [8]byte
+	for x := range s.Samples(n) {
+		binary.LittleEndian.PutUint64(buf[:], math.Float64bits(x))
+		if _, err := w.Write(buf[:]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// The header of a version 1.0 .npy file, padded with spaces so the data is aligned to 64 bytes.
+// See https://numpy.org/doc/stable/reference/generated/numpy.lib.format.html.
+func npyHeader(n int) []byte {
+	const prefix = "\x93NUMPY\x01\x00"
+	dict := fmt.Sprintf("{'descr': '<f8', 'fortran_order': False, 'shape': (%d,), }", n)
+	// The prefix and header length take 10 bytes, and the dict ends with a newline.
+	pad := -(len(prefix) + 2 + len(dict) + 1) & 63
+	dict += strings.Repeat(" ", pad) + "\n"
+	header := []byte(prefix)
+	header = binary.LittleEndian.AppendUint16(header, uint16(len(dict)))
+	return append(header, dict...)
+}
+
+func writeNPY(w *bufio.Writer, s ziggurat.Sampler, n int) error {
+	if _, err := w.Write(npyHeader(n)); err != nil {
+		return err
+	}
+	return writeRaw(w, s, n)
+}
@@ -0,0 +1,116 @@
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+const TEST_SAMPLES = 1000
+
+// Parse the samples written in format.
+func parse(t *testing.T, format string, data []byte) []float64 {
+	t.Helper()
+	switch format {
+	case "csv":
+		header, rest, ok := bytes.Cut(data, []byte("\n"))
+		if !ok || string(header) != "x" {
+			t.Fatalf("CSV header %q, want %q", header, "x")
+		}
+		data = rest
+		fallthrough
+	case "text":
+		var samples []float64
+		for _, line := range strings.Split(strings.TrimSuffix(string(data), "\n"), "\n") {
+			x, err := strconv.ParseFloat(line, 64)
+			if err != nil {
+				t.Fatal(err)
+			}
+			samples = append(samples, x)
+		}
+		return samples
+	case "npy":
+		if !bytes.HasPrefix(data, []byte("\x93NUMPY\x01\x00")) {
+			t.Fatalf("Invalid npy magic %q", data[:8])
+		}
+		n := 10 + int(binary.LittleEndian.Uint16(data[8:10]))
+		if n%64 != 0 || data[n-1] != '\n' {
+			t.Fatalf("npy header of length %d is not aligned, or doesn't end in a newline", n)
+		}
+		if want := fmt.Sprintf("{'descr': '<f8', 'fortran_order': False, 'shape': (%d,), }", TEST_SAMPLES); strings.TrimRight(string(data[10:n]), " \n") != want {
+			t.Fatalf("npy header %q, want %q", data[10:n], want)
+		}
+		data = data[n:]
+		fallthrough
+	case "raw":
+		samples := make([]float64, len(data)/8)
+		for i := range samples {
+			samples[i] = math.Float64frombits(binary.LittleEndian.Uint64(data[8*i:]))
+		}
+		return samples
+	}
+	t.Fatalf("Unknown format %s", format)
+	return nil
+}
+
+// Every format must write the same samples, reproducibly from the seed, to stdout or a file.
+func TestFormats(t *testing.T) {
+	for _, dist := range [][]string{{"-dist", "normal"}, {"-dist", "gamma", "-alpha", "0.5"}} {
+		t.Run(dist[1], func(t *testing.T) {
+			var want []float64
+			for _, format := range formatNames() {
+				args := append(dist, "-n", strconv.Itoa(TEST_SAMPLES), "-seed", "42", "-format", format)
+				var stdout bytes.Buffer
+				if err := run(args, &stdout); err != nil {
+					t.Fatal(err)
+				}
+				file := filepath.Join(t.TempDir(), "samples")
+				if err := run(append(args, "-o", file), nil); err != nil {
+					t.Fatal(err)
+				}
+				data, err := os.ReadFile(file)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !bytes.Equal(data, stdout.Bytes()) {
+					t.Errorf("%s file differs from stdout", format)
+				}
+				got := parse(t, format, data)
+				if len(got) != TEST_SAMPLES {
+					t.Fatalf("%s wrote %d samples, want %d", format, len(got), TEST_SAMPLES)
+				}
+				if want == nil {
+					want = got
+					continue
+				}
+				for i := range got {
+					if got[i] != want[i] {
+						t.Fatalf("%s sample %d = %v, want %v", format, i, got[i], want[i])
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestRunErrors(t *testing.T) {
+	for _, args := range [][]string{
+		{"-dist", "cauchy"},
+		{"-dist", "gamma"},
+		{"-dist", "normal", "-alpha", "2"},
+		{"-dist", "normal", "-format", "parquet"},
+		{"-dist", "normal", "-n", "-1"},
+		{"-dist", "normal", "-strips", "3"},
+		{"-dist", "normal", "extra"},
+	} {
+		if err := run(args, &bytes.Buffer{}); err == nil {
+			t.Errorf("run(%q) did not return an error", args)
+		}
+	}
+}
@@ -0,0 +1,136 @@
+package ziggurat
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+)
+
+type DiscreteDistribution interface {
+	Mode() int64              // The k value for which the PMF is maximized.
+	Prob(k int64) float64     // The PMF function for this distribution.
+	Survival(k int64) float64 // The survival function (1-CDF), the probability of a value greater than k.
+}
+
+// A DiscreteSampler is a random number generator built from a DiscreteDistribution.
+type DiscreteSampler interface {
+	Rand() int64
+	// Fill fills dst with independent samples from the distribution of Rand.
+	// It may draw from the source in a different order than calling Rand for each element, so it can give different samples.
+	Fill(dst []int64)
+	// Clone returns a copy of the sampler which shares its tables but draws from src, for use on another goroutine.
+	Clone(src rand.Source) DiscreteSampler
+	// Stats reports the expected efficiency of the sampler, and the accuracy of its tables.
+	Stats() Stats
+}
+
+// Spread the probability of each k uniformly over [k, k+1), so the continuous ziggurat can sample it.
+type stepDistribution struct {
+	d DiscreteDistribution
+}
+
+// The largest float64 for which a step is represented by an int64.
+const maxStep = float64(math.MaxInt64)
+
+func (d stepDistribution) Mode() float64 {
+	return float64(d.d.Mode())
+}
+
+func (d stepDistribution) Prob(x float64) float64 {
+	if math.IsNaN(x) {
+		return math.NaN()
+	}
+	if x < -maxStep || x >= maxStep {
+		return 0.0
+	}
+	return d.d.Prob(int64(math.Floor(x)))
+}
+
+func (d stepDistribution) Survival(x float64) float64 {
+	if math.IsNaN(x) {
+		return math.NaN()
+	}
+	if x < -maxStep {
+		return 1.0
+	}
+	if x >= maxStep {
+		return 0.0
+	}
+	k := math.Floor(x)
+	return d.d.Survival(int64(k)) + (k+1-x)*d.d.Prob(int64(k))
+}
+
+func (d stepDistribution) Quantile(p float64) float64 {
+	s := 1 - p
+	if s <= 0 {
+		// The end of the support, where the survival reaches zero.
+		k, ok := searchInt(func(k int64) bool { return d.d.Survival(k) <= 0 })
+		if !ok {
+			return math.Inf(1)
+		}
+		return float64(k) + 1
+	}
+	k, ok := searchInt(func(k int64) bool { return d.d.Survival(k) < s })
+	if !ok {
+		return math.Inf(1)
+	}
+	if k == math.MinInt64 {
+		return math.Inf(-1)
+	}
+	prob := d.d.Prob(k)
+	if prob <= 0 {
+		return float64(k) + 1
+	}
+	return float64(k) + 1 - (s-d.d.Survival(k))/prob
+}
+
+type discreteZiggurat struct {
+	s     Sampler
+	batch *[sampleBatch]float64 // The continuous samples for Fill, allocated on its first call.
+}
+
+// ToDiscreteZiggurat constructs a DiscreteSampler for distribution. It panics if the ziggurat cannot be constructed.
+func ToDiscreteZiggurat(distribution DiscreteDistribution, src rand.Source) DiscreteSampler {
+	return ToDiscreteZigguratWithOptions(distribution, src, Options{})
+}
+
+// ToDiscreteZigguratWithOptions is like ToDiscreteZiggurat, but configured by opts. It panics if the ziggurat cannot be constructed.
+func ToDiscreteZigguratWithOptions(distribution DiscreteDistribution, src rand.Source, opts Options) DiscreteSampler {
+	s, err := NewDiscreteZiggurat(distribution, src, opts)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// NewDiscreteZiggurat constructs a DiscreteSampler for distribution, returning an error if the distribution is invalid.
+// The modes of distribution and the values it may take must be exactly representable as float64.
+func NewDiscreteZiggurat(distribution DiscreteDistribution, src rand.Source, opts Options) (_ DiscreteSampler, err error) {
+	defer recoverDistribution(&err)
+	if mode := distribution.Mode(); float64(mode) >= maxStep || int64(float64(mode)) != mode {
+		return nil, fmt.Errorf("%w: mode %d is not representable as a float64", ErrNotUnimodal, mode)
+	}
+	s, err := NewZiggurat(stepDistribution{d: distribution}, src, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &discreteZiggurat{s: s}, nil
+}
+
+func (z *discreteZiggurat) Rand() int64 {
+	return int64(math.Floor(z.s.Rand()))
+}
+
+func (z *discreteZiggurat) Fill(dst []int64) {
+	if z.batch == nil {
+		z.batch = new([sampleBatch]float64)
+	}
+	for len(dst) > 0 {
+		n := min(len(dst), sampleBatch)
+		z.s.Fill(z.batch[:n])
+		for i, x := range z.batch[:n] {
+			dst[i] = int64(math.Floor(x))
+		}
+		dst = dst[n:]
+	}
+}
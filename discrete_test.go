@@ -0,0 +1,160 @@
+package ziggurat_test
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/argusdusty/ziggurat"
+	"github.com/vpxyz/xorshift/xoroshiro128plus"
+	"gonum.org/v1/gonum/stat/distuv"
+)
+
+const (
+	DISCRETE_ALPHA   = 0.001
+	DISCRETE_SAMPLES = 100_000
+)
+
+type Poisson struct {
+	distuv.Poisson
+}
+
+func (P Poisson) Mode() int64 {
+	return int64(math.Floor(P.Lambda))
+}
+
+func (P Poisson) Prob(k int64) float64 {
+	return P.Poisson.Prob(float64(k))
+}
+
+func (P Poisson) Survival(k int64) float64 {
+	return P.Poisson.Survival(float64(k))
+}
+
+type Binomial struct {
+	distuv.Binomial
+}
+
+func (B Binomial) Mode() int64 {
+	return int64(math.Floor((B.N + 1) * B.P))
+}
+
+func (B Binomial) Prob(k int64) float64 {
+	return B.Binomial.Prob(float64(k))
+}
+
+func (B Binomial) Survival(k int64) float64 {
+	return B.Binomial.Survival(float64(k))
+}
+
+var DISCRETE_DISTRIBUTIONS = []struct {
+	Name string
+	Dist ziggurat.DiscreteDistribution
+}{
+	{Name: "Poisson(0.5)", Dist: Poisson{distuv.Poisson{Lambda: 0.5}}},
+	{Name: "Poisson(3)", Dist: Poisson{distuv.Poisson{Lambda: 3}}},
+	{Name: "Poisson(10.5)", Dist: Poisson{distuv.Poisson{Lambda: 10.5}}},
+	{Name: "Poisson(1000)", Dist: Poisson{distuv.Poisson{Lambda: 1000}}},
+	{Name: "Binomial(1,0.3)", Dist: Binomial{distuv.Binomial{N: 1, P: 0.3}}},
+	{Name: "Binomial(20,0.5)", Dist: Binomial{distuv.Binomial{N: 20, P: 0.5}}},
+	{Name: "Binomial(100,0.02)", Dist: Binomial{distuv.Binomial{N: 100, P: 0.02}}},
+	{Name: "Binomial(50,0.99)", Dist: Binomial{distuv.Binomial{N: 50, P: 0.99}}},
+}
+
+// Chi-squared test of the sample frequencies, merging values with too few expected samples into their neighbours.
+// False positive rate alpha.
+func testFrequencies(t *testing.T, samples []int64, d ziggurat.DiscreteDistribution, alpha float64) {
+	counts := map[int64]float64{}
+	lo, hi := samples[0], samples[0]
+	for _, k := range samples {
+		counts[k]++
+		lo, hi = min(lo, k), max(hi, k)
+	}
+	for d.Prob(lo-1) > 0 {
+		lo--
+	}
+	for d.Prob(hi+1) > 0 && d.Survival(hi) > 1e-12 {
+		hi++
+	}
+	N := float64(len(samples))
+	var chi2, observed, expected float64
+	bins := 0
+	for k := lo; k <= hi; k++ {
+		observed += counts[k]
+		expected += d.Prob(k) * N
+		if k == lo {
+			expected = (1 - d.Survival(k)) * N
+		}
+		if k == hi {
+			expected += d.Survival(k) * N
+		}
+		if expected < 5 && k < hi {
+			continue
+		}
+		chi2 += (observed - expected) * (observed - expected) / expected
+		observed, expected = 0, 0
+		bins++
+	}
+	if bins < 2 {
+		return
+	}
+	p := distuv.ChiSquared{K: float64(bins - 1)}.Survival(chi2)
+	if p < alpha {
+		t.Errorf("%s distribution random variate with %d samples produced incorrect frequencies with chi-squared %v over %d bins, p-value %v", t.Name(), len(samples), chi2, bins, p)
+	}
+}
+
+func TestDiscrete(t *testing.T) {
+	for _, d := range DISCRETE_DISTRIBUTIONS {
+		t.Run(d.Name, func(t *testing.T) {
+			Z := ziggurat.ToDiscreteZiggurat(d.Dist, xoroshiro128plus.NewSource(rand.Int64()))
+			var samples = make([]int64, DISCRETE_SAMPLES)
+			Z.Fill(samples)
+			testFrequencies(t, samples, d.Dist, DISCRETE_ALPHA)
+		})
+	}
+}
+
+// TestDiscrete checks the samples of Fill, which may differ from those of Rand, so Rand must sample the distribution too.
+func TestDiscreteRand(t *testing.T) {
+	for _, d := range DISCRETE_DISTRIBUTIONS {
+		t.Run(d.Name, func(t *testing.T) {
+			Z := ziggurat.ToDiscreteZiggurat(d.Dist, xoroshiro128plus.NewSource(rand.Int64()))
+			var samples = make([]int64, DISCRETE_SAMPLES)
+			for i := range samples {
+				samples[i] = Z.Rand()
+			}
+			testFrequencies(t, samples, d.Dist, DISCRETE_ALPHA)
+		})
+	}
+}
+
+func BenchmarkDiscrete(b *testing.B) {
+	for _, d := range DISCRETE_DISTRIBUTIONS {
+		b.Run(d.Name, func(b *testing.B) {
+			Z := ziggurat.ToDiscreteZiggurat(d.Dist, xoroshiro128plus.NewSource(rand.Int64()))
+			for b.Loop() {
+				Z.Rand()
+			}
+		})
+	}
+}
+
+func BenchmarkGonumDiscrete(b *testing.B) {
+	for _, d := range DISCRETE_DISTRIBUTIONS {
+		b.Run(d.Name, func(b *testing.B) {
+			var R distuv.Rander
+			switch D := d.Dist.(type) {
+			case Poisson:
+				D.Src = xoroshiro128plus.NewSource(rand.Int64())
+				R = D.Poisson
+			case Binomial:
+				D.Src = xoroshiro128plus.NewSource(rand.Int64())
+				R = D.Binomial
+			}
+			for b.Loop() {
+				R.Rand()
+			}
+		})
+	}
+}
@@ -1,5 +1,11 @@
 package ziggurat
 
+import (
+	"math"
+
+	"github.com/argusdusty/ziggurat/internal/quad"
+)
+
 type Distribution interface {
 	Mode() float64              // The x value for which the PDF is maximized.
 	Prob(x float64) float64     // The PDF function for this distribution.
@@ -7,6 +13,48 @@ type Distribution interface {
 	Quantile(p float64) float64 // The quantile function (integral(CDF)) for this distribution.
 }
 
+// A LogDistribution is a Distribution which can also compute the log of its density, such as gonum's distributions.
+// Samplers build their tables from the log density, and compare densities in log space, which stays accurate where the
+// density itself under- or overflows.
+type LogDistribution interface {
+	Distribution
+	LogProb(x float64) float64 // The log of the PDF function for this distribution.
+}
+
+func logProb(d Distribution, x float64) float64 {
+	if l, ok := d.(LogDistribution); ok {
+		return l.LogProb(x)
+	}
+	return math.Log(d.Prob(x))
+}
+
+// Whether d computes its log density directly, rather than as the log of its density, looking through the wrappers here.
+func hasLogProb(d Distribution) bool {
+	switch d := d.(type) {
+	case zeroModeDistribution:
+		return hasLogProb(d.Distribution)
+	case truncatedDistribution:
+		return hasLogProb(d.Distribution)
+	case flippedDistribution:
+		return hasLogProb(d.Distribution)
+	case gonumDistribution:
+		_, ok := d.GonumDistribution.(interface{ LogProb(x float64) float64 })
+		return ok
+	case *pdfDistribution:
+		return d.logPDF != nil
+	}
+	_, ok := d.(LogDistribution)
+	return ok
+}
+
+// The density of d, from its log density if logDensity is set, as the density computed directly may under- or overflow where its log doesn't.
+func density(d Distribution, logDensity bool, x float64) float64 {
+	if logDensity {
+		return math.Exp(logProb(d, x))
+	}
+	return d.Prob(x)
+}
+
 type zeroModeDistribution struct {
 	Distribution
 }
@@ -19,54 +67,202 @@ func (d zeroModeDistribution) Prob(x float64) float64 {
 	return d.Distribution.Prob(x + d.Distribution.Mode())
 }
 
+func (d zeroModeDistribution) LogProb(x float64) float64 {
+	return logProb(d.Distribution, x+d.Distribution.Mode())
+}
+
 func (d zeroModeDistribution) Survival(x float64) float64 {
 	return d.Distribution.Survival(x + d.Distribution.Mode())
 }
 
+func (d zeroModeDistribution) CDF(x float64) float64 {
+	return cdf(d.Distribution, x+d.Distribution.Mode())
+}
+
 func (d zeroModeDistribution) Quantile(p float64) float64 {
 	return d.Distribution.Quantile(p) - d.Distribution.Mode()
 }
 
-// Bound the distribution from below (at the mode).
-type truncatedBelowDistribution struct {
+// Bound the distribution to [lo, hi], with the given mode within the bounds.
+type truncatedDistribution struct {
 	Distribution
+	lo, hi, mode float64
+	logMass      float64 // The log probability of the underlying distribution between lo and hi, which may underflow itself.
 }
 
-func (d truncatedBelowDistribution) Mode() float64 {
-	return d.Distribution.Mode()
+func newTruncated(d Distribution, lo, hi, mode float64) truncatedDistribution {
+	t := truncatedDistribution{Distribution: d, lo: lo, hi: hi, mode: mode}
+	t.logMass = t.logBetween(lo, hi)
+	return t
 }
 
-func (d truncatedBelowDistribution) Prob(x float64) float64 {
-	return d.Distribution.Prob(x) / d.Distribution.Survival(d.Mode())
+// Bound the distribution from below (at the mode).
+func truncatedBelow(d Distribution) truncatedDistribution {
+	return newTruncated(d, d.Mode(), math.Inf(1), d.Mode())
 }
 
-func (d truncatedBelowDistribution) Survival(x float64) float64 {
-	return d.Distribution.Survival(x) / d.Distribution.Survival(d.Mode())
+// Bound the distribution from above (at the mode).
+func truncatedAbove(d Distribution) truncatedDistribution {
+	return newTruncated(d, math.Inf(-1), d.Mode(), d.Mode())
 }
 
-func (d truncatedBelowDistribution) Quantile(p float64) float64 {
-	return d.Distribution.Quantile(p + (1-p)*d.Distribution.Survival(d.Mode()))
+func (d truncatedDistribution) Mode() float64 {
+	return d.mode
 }
 
-// Bound the distribution from above (at the mode).
-type truncatedAboveDistribution struct {
-	Distribution
+// A distribution which can compute its CDF more accurately than 1-Survival, such as gonum's distributions.
+type cdfDistribution interface {
+	CDF(x float64) float64
 }
 
-func (d truncatedAboveDistribution) Mode() float64 {
-	return d.Distribution.Mode()
+func cdf(d Distribution, x float64) float64 {
+	if c, ok := d.(cdfDistribution); ok {
+		return c.CDF(x)
+	}
+	return 1 - d.Survival(x)
+}
+
+// The survival of the underlying distribution, exact at infinite bounds.
+func (d truncatedDistribution) survival(x float64) float64 {
+	if math.IsInf(x, -1) {
+		return 1.0
+	}
+	if math.IsInf(x, 1) {
+		return 0.0
+	}
+	return d.Distribution.Survival(x)
+}
+
+// The CDF of the underlying distribution, exact at infinite bounds.
+func (d truncatedDistribution) cdf(x float64) float64 {
+	if math.IsInf(x, -1) {
+		return 0.0
+	}
+	if math.IsInf(x, 1) {
+		return 1.0
+	}
+	return cdf(d.Distribution, x)
+}
+
+// The log probability of the underlying distribution between a and b, from whichever tail is more accurate: the survival
+// above the mode of the truncated distribution and the CDF below it. Where that underflows or cancels, as far into a tail, the density is integrated
+// instead, relative to the density at the bound nearest the mode where it has a log density, so the log probability
+// stays finite where the probability underflows.
+func (d truncatedDistribution) logBetween(a, b float64) float64 {
+	if !(a < b) {
+		return math.Inf(-1)
+	}
+	var p float64
+	mode := d.mode
+	switch {
+	case a >= mode:
+		p = d.survival(a) - d.survival(b)
+	case b <= mode:
+		p = d.cdf(b) - d.cdf(a)
+	default:
+		p = 1 - d.cdf(a) - d.survival(b)
+	}
+	if p > 0 {
+		return math.Log(p)
+	}
+	bound, sign := a, 1.0
+	if b <= mode {
+		bound, sign = b, -1.0
+	}
+	ref := logProb(d.Distribution, bound)
+	if !hasLogProb(d.Distribution) || (a < mode && b > mode) || math.IsInf(ref, 0) || math.IsNaN(ref) {
+		return math.Log(integrateProb(d.Distribution, a, b))
+	}
+	// Integrate the density relative to ref away from the bound, over t = w*u/(1-u), with w the scale over which the log
+	// density falls by one, from its slope, so that the mass next to the bound isn't lost between the nodes.
+	scale := max(math.Abs(bound), 1)
+	h := 0x1p-20 * scale
+	w := scale
+	if slope := (logProb(d.Distribution, bound+sign*h) - ref) / h; slope < 0 {
+		w = min(-1/slope, scale)
+	}
+	f := func(u float64) float64 {
+		v := 1 - u
+		return math.Exp(logProb(d.Distribution, bound+sign*w*u/v)-ref) * w / (v * v)
+	}
+	// The log density is only accurate to its rounding, relative to its magnitude, and so is the density relative to ref.
+	tol := max(quad.Tol, 0x1p-48*math.Abs(ref))
+	uMax := 1.0
+	if length := b - a; !math.IsInf(length, 1) {
+		uMax = length / (w + length)
+	}
+	return ref + math.Log(quad.IntegrateTol(f, 0, uMax, tol))
 }
 
-func (d truncatedAboveDistribution) Prob(x float64) float64 {
-	return d.Distribution.Prob(x) / (1 - d.Distribution.Survival(d.Mode()))
+func (d truncatedDistribution) Prob(x float64) float64 {
+	if x < d.lo || x > d.hi {
+		return 0.0
+	}
+	return math.Exp(logProb(d.Distribution, x) - d.logMass)
 }
 
-func (d truncatedAboveDistribution) Survival(x float64) float64 {
-	return 1 - (1-d.Distribution.Survival(x))/(1-d.Distribution.Survival(d.Mode()))
+func (d truncatedDistribution) LogProb(x float64) float64 {
+	if x < d.lo || x > d.hi {
+		return math.Inf(-1)
+	}
+	return logProb(d.Distribution, x) - d.logMass
 }
 
-func (d truncatedAboveDistribution) Quantile(p float64) float64 {
-	return d.Distribution.Quantile(p * (1 - d.Distribution.Survival(d.Mode())))
+func (d truncatedDistribution) Survival(x float64) float64 {
+	if x < d.lo {
+		return 1.0
+	}
+	if x >= d.hi {
+		return 0.0
+	}
+	return math.Exp(d.logBetween(x, d.hi) - d.logMass)
+}
+
+func (d truncatedDistribution) CDF(x float64) float64 {
+	if x < d.lo {
+		return 0.0
+	}
+	if x >= d.hi {
+		return 1.0
+	}
+	return math.Exp(d.logBetween(d.lo, x) - d.logMass)
+}
+
+// The smallest survival for which the underlying Quantile is accurate, as 1-p loses precision for smaller values.
+const minQuantileSurvival = 1e-6
+
+func (d truncatedDistribution) Quantile(p float64) float64 {
+	if p <= 0 {
+		return d.lo
+	}
+	if p >= 1 {
+		return d.hi
+	}
+	mass := math.Exp(d.logMass)
+	if s := d.survival(d.lo); s < 0.5 {
+		target := s - p*mass
+		if target < minQuantileSurvival {
+			// Deep in the upper tail, so invert the survival function directly.
+			x, err := searchFloat(func(x float64) bool {
+				return x >= d.hi || (x > d.lo && d.logBetween(x, d.hi) <= math.Log1p(-p)+d.logMass)
+			})
+			if err != nil {
+				return math.NaN()
+			}
+			return min(max(x, d.lo), d.hi)
+		}
+		return min(max(d.Distribution.Quantile(1-target), d.lo), d.hi)
+	}
+	target := d.cdf(d.lo) + p*mass
+	if target < 0x1p-1022 {
+		// The probability underflows, far into the lower tail, so invert the CDF in log space.
+		x, err := searchFloat(func(x float64) bool { return x >= d.hi || (x > d.lo && d.logBetween(d.lo, x) >= math.Log(p)+d.logMass) })
+		if err != nil {
+			return math.NaN()
+		}
+		return min(max(x, d.lo), d.hi)
+	}
+	return min(max(d.Distribution.Quantile(min(target, 1)), d.lo), d.hi)
 }
 
 // Flip the distribution around its mode.
@@ -82,10 +278,18 @@ func (d flippedDistribution) Prob(x float64) float64 {
 	return d.Distribution.Prob(2*d.Mode() - x)
 }
 
+func (d flippedDistribution) LogProb(x float64) float64 {
+	return logProb(d.Distribution, 2*d.Mode()-x)
+}
+
 func (d flippedDistribution) Survival(x float64) float64 {
 	return 1 - d.Distribution.Survival(2*d.Mode()-x)
 }
 
+func (d flippedDistribution) CDF(x float64) float64 {
+	return d.Distribution.Survival(2*d.Mode() - x)
+}
+
 func (d flippedDistribution) Quantile(p float64) float64 {
 	return 2*d.Mode() - d.Distribution.Quantile(1-p)
 }
@@ -0,0 +1,119 @@
+package dists_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/argusdusty/ziggurat"
+	"github.com/argusdusty/ziggurat/dists"
+	"github.com/argusdusty/ziggurat/zigsrc"
+	"github.com/argusdusty/ziggurat/zigtest"
+)
+
+// Apery's constant, zeta(3).
+const ZETA3 = 1.2020569031595942
+
+// The moments of the half-normal distribution with scale sigma.
+func halfNormalMoment(sigma float64) func(m uint64) float64 {
+	return func(m uint64) float64 {
+		return math.Pow(sigma*math.Sqrt2, float64(m)) * math.Gamma((float64(m)+1)/2) / math.SqrtPi
+	}
+}
+
+// The moments of (Y-1)/xi for Y = E^-xi, E ~ Exponential(1), which is GEV(0, 1, xi) distributed.
+func gevMoment(xi float64) func(m uint64) float64 {
+	return func(m uint64) float64 {
+		var sum float64
+		binomial := 1.0
+		for k := uint64(0); k <= m; k++ {
+			term := binomial * math.Gamma(1-float64(k)*xi)
+			if (m-k)&1 == 1 {
+				term = -term
+			}
+			sum += term
+			binomial = binomial * float64(m-k) / float64(k+1)
+		}
+		return sum / math.Pow(xi, float64(m))
+	}
+}
+
+// The moments of the standard skew-normal distribution, up to the fourth.
+func skewNormalMoment(alpha float64) func(m uint64) float64 {
+	delta := alpha / math.Sqrt(1+alpha*alpha)
+	b := math.Sqrt(2 / math.Pi)
+	return func(m uint64) float64 {
+		return [...]float64{1, b * delta, 1, b * delta * (3 - delta*delta), 3}[m]
+	}
+}
+
+var DISTS_DISTRIBUTIONS = []struct {
+	Dist      ziggurat.Distribution
+	Moment    func(m uint64) float64
+	MaxMoment uint64
+}{
+	{dists.HalfNormal{Sigma: 2}, halfNormalMoment(2), 4},
+	// The half-Cauchy distribution has no moments.
+	{dists.HalfCauchy{Scale: 1.5}, nil, 0},
+	{dists.Rayleigh{Sigma: 2}, func(m uint64) float64 { return math.Pow(2*math.Sqrt2, float64(m)) * math.Gamma(1+float64(m)/2) }, 4},
+	{dists.Maxwell{A: 1.5}, func(m uint64) float64 {
+		return math.Pow(1.5*math.Sqrt2, float64(m)) * math.Gamma((3+float64(m))/2) / math.Gamma(1.5)
+	}, 4},
+	{dists.Gumbel{Mu: 0, Beta: 1}, func(m uint64) float64 {
+		g := 0.57721566490153286
+		return [...]float64{1, g, g*g + math.Pi*math.Pi/6, g*g*g + g*math.Pi*math.Pi/2 + 2*ZETA3, g*g*g*g + g*g*math.Pi*math.Pi + 8*g*ZETA3 + 3*math.Pow(math.Pi, 4)/20}[m]
+	}, 2},
+	{dists.Frechet{Alpha: 10, S: 1, M: 0}, func(m uint64) float64 { return math.Gamma(1 - float64(m)/10) }, 4},
+	{dists.LogLogistic{Alpha: 1, Beta: 10}, func(m uint64) float64 {
+		if m == 0 {
+			return 1.0
+		}
+		b := float64(m) * math.Pi / 10
+		return b / math.Sin(b)
+	}, 4},
+	// An infinite peak at zero, and no moments.
+	{dists.LogLogistic{Alpha: 1, Beta: 0.5}, nil, 0},
+	{dists.GEV{Mu: 0, Sigma: 1, Xi: 0.1}, gevMoment(0.1), 4},
+	{dists.GEV{Mu: 0, Sigma: 1, Xi: -0.3}, gevMoment(-0.3), 4},
+	{dists.SkewNormal{Xi: 0, Omega: 1, Alpha: 4}, skewNormalMoment(4), 2},
+	{dists.SkewNormal{Xi: 0, Omega: 1, Alpha: -3}, skewNormalMoment(-3), 2},
+	{dists.Kumaraswamy{A: 2, B: 5}, kumaraswamyMoment(2, 5), 4},
+	// An infinite peak at zero.
+	{dists.Kumaraswamy{A: 0.5, B: 3}, kumaraswamyMoment(0.5, 3), 4},
+}
+
+func kumaraswamyMoment(a, b float64) func(m uint64) float64 {
+	return func(m uint64) float64 {
+		lb, _ := math.Lgamma(b)
+		lx, _ := math.Lgamma(1 + float64(m)/a)
+		ly, _ := math.Lgamma(1 + b + float64(m)/a)
+		return b * math.Exp(lb+lx-ly)
+	}
+}
+
+func TestDists(t *testing.T) {
+	for _, test := range DISTS_DISTRIBUTIONS {
+		t.Run(fmt.Sprintf("%T%+v", test.Dist, test.Dist), func(t *testing.T) {
+			if _, ok := test.Dist.(ziggurat.LogDistribution); !ok {
+				t.Errorf("%T is not a LogDistribution", test.Dist)
+			}
+			Z := ziggurat.ToZiggurat(test.Dist, zigsrc.NewXoroshiro128Plus(1))
+			if err := zigtest.Test(test.Dist, Z, zigtest.Options{Moment: test.Moment, MaxMoment: test.MaxMoment}); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func BenchmarkDists(b *testing.B) {
+	for _, test := range DISTS_DISTRIBUTIONS {
+		b.Run(fmt.Sprintf("%T%+v", test.Dist, test.Dist), func(b *testing.B) {
+			Z := ziggurat.ToZiggurat(test.Dist, zigsrc.NewXoroshiro128Plus(1))
+			var dst = make([]float64, 1024)
+			for b.Loop() {
+				Z.Fill(dst)
+			}
+			b.ReportMetric(float64(b.Elapsed().Nanoseconds())/float64(b.N*len(dst)), "ns/sample")
+		})
+	}
+}
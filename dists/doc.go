@@ -0,0 +1,6 @@
+// Package dists implements continuous distributions for use with ziggurat, which gonum lacks or computes inaccurately in the tails.
+//
+// Each distribution implements ziggurat.LogDistribution, along with a CDF method.
+// The survival function and CDF are each computed directly, rather than as one minus the other, so both stay accurate in the tails.
+// Parameters are not validated: as with gonum, the results for invalid parameters are unspecified.
+package dists
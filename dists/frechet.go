@@ -0,0 +1,42 @@
+package dists
+
+import "math"
+
+// Frechet is the Fréchet distribution with shape Alpha, scale S and location M, supported on (M, inf).
+type Frechet struct {
+	Alpha, S, M float64
+}
+
+func (d Frechet) Mode() float64 {
+	return d.M + d.S*math.Pow(d.Alpha/(1+d.Alpha), 1/d.Alpha)
+}
+
+func (d Frechet) Prob(x float64) float64 {
+	return math.Exp(d.LogProb(x))
+}
+
+func (d Frechet) LogProb(x float64) float64 {
+	if x <= d.M {
+		return math.Inf(-1)
+	}
+	logZ := math.Log((x - d.M) / d.S)
+	return math.Log(d.Alpha/d.S) - (1+d.Alpha)*logZ - math.Exp(-d.Alpha*logZ)
+}
+
+func (d Frechet) CDF(x float64) float64 {
+	if x <= d.M {
+		return 0.0
+	}
+	return math.Exp(-math.Pow((x-d.M)/d.S, -d.Alpha))
+}
+
+func (d Frechet) Survival(x float64) float64 {
+	if x <= d.M {
+		return 1.0
+	}
+	return -math.Expm1(-math.Pow((x-d.M)/d.S, -d.Alpha))
+}
+
+func (d Frechet) Quantile(p float64) float64 {
+	return d.M + d.S*math.Pow(-math.Log(p), -1/d.Alpha)
+}
@@ -0,0 +1,61 @@
+package dists
+
+import "math"
+
+// GEV is the generalized extreme value distribution with location Mu, scale Sigma and shape Xi.
+// Xi > 0 gives the Fréchet family, bounded below, Xi < 0 the reversed Weibull family, bounded above, and Xi = 0 the Gumbel distribution.
+type GEV struct {
+	Mu, Sigma, Xi float64
+}
+
+// The log of t(x), where the CDF is exp(-t(x)).
+func (d GEV) logT(x float64) float64 {
+	z := (x - d.Mu) / d.Sigma
+	if d.Xi == 0 {
+		return -z
+	}
+	if 1+d.Xi*z <= 0 {
+		// Outside the support, below it for Xi > 0 and above it for Xi < 0.
+		return math.Copysign(math.Inf(1), d.Xi)
+	}
+	return -math.Log1p(d.Xi*z) / d.Xi
+}
+
+func (d GEV) Mode() float64 {
+	if d.Xi == 0 {
+		return d.Mu
+	}
+	if d.Xi <= -1 {
+		// The density is increasing up to the end of the support.
+		return d.Mu - d.Sigma/d.Xi
+	}
+	return d.Mu + d.Sigma*math.Expm1(-d.Xi*math.Log1p(d.Xi))/d.Xi
+}
+
+func (d GEV) Prob(x float64) float64 {
+	return math.Exp(d.LogProb(x))
+}
+
+func (d GEV) LogProb(x float64) float64 {
+	logT := d.logT(x)
+	if math.IsInf(logT, 0) {
+		return math.Inf(-1)
+	}
+	return (d.Xi+1)*logT - math.Exp(logT) - math.Log(d.Sigma)
+}
+
+func (d GEV) CDF(x float64) float64 {
+	return math.Exp(-math.Exp(d.logT(x)))
+}
+
+func (d GEV) Survival(x float64) float64 {
+	return -math.Expm1(-math.Exp(d.logT(x)))
+}
+
+func (d GEV) Quantile(p float64) float64 {
+	logT := math.Log(-math.Log(p))
+	if d.Xi == 0 {
+		return d.Mu - d.Sigma*logT
+	}
+	return d.Mu + d.Sigma*math.Expm1(-d.Xi*logT)/d.Xi
+}
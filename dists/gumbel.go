@@ -0,0 +1,34 @@
+package dists
+
+import "math"
+
+// Gumbel is the Gumbel distribution of maxima, with location Mu and scale Beta.
+// Unlike gonum's GumbelRight, the survival function is accurate in the upper tail.
+type Gumbel struct {
+	Mu, Beta float64
+}
+
+func (d Gumbel) Mode() float64 {
+	return d.Mu
+}
+
+func (d Gumbel) Prob(x float64) float64 {
+	return math.Exp(d.LogProb(x))
+}
+
+func (d Gumbel) LogProb(x float64) float64 {
+	z := (x - d.Mu) / d.Beta
+	return -math.Log(d.Beta) - z - math.Exp(-z)
+}
+
+func (d Gumbel) CDF(x float64) float64 {
+	return math.Exp(-math.Exp(-(x - d.Mu) / d.Beta))
+}
+
+func (d Gumbel) Survival(x float64) float64 {
+	return -math.Expm1(-math.Exp(-(x - d.Mu) / d.Beta))
+}
+
+func (d Gumbel) Quantile(p float64) float64 {
+	return d.Mu - d.Beta*math.Log(-math.Log(p))
+}
@@ -0,0 +1,54 @@
+package dists
+
+import "math"
+
+// HalfCauchy is the distribution of |X| for X Cauchy distributed with location zero and scale Scale.
+type HalfCauchy struct {
+	Scale float64
+}
+
+func (d HalfCauchy) Mode() float64 {
+	return 0.0
+}
+
+func (d HalfCauchy) Prob(x float64) float64 {
+	if x < 0 {
+		return 0.0
+	}
+	z := x / d.Scale
+	return 2 / (math.Pi * d.Scale * (1 + z*z))
+}
+
+func (d HalfCauchy) LogProb(x float64) float64 {
+	if x < 0 {
+		return math.Inf(-1)
+	}
+	z := x / d.Scale
+	if z > 1e150 {
+		// Avoid overflowing z*z.
+		return math.Log(2/(math.Pi*d.Scale)) - 2*math.Log(z)
+	}
+	return math.Log(2/(math.Pi*d.Scale)) - math.Log1p(z*z)
+}
+
+func (d HalfCauchy) CDF(x float64) float64 {
+	if x <= 0 {
+		return 0.0
+	}
+	return 2 / math.Pi * math.Atan(x/d.Scale)
+}
+
+func (d HalfCauchy) Survival(x float64) float64 {
+	if x <= 0 {
+		return 1.0
+	}
+	// atan(1/z) = pi/2 - atan(z), without the cancellation for large z.
+	return 2 / math.Pi * math.Atan(d.Scale/x)
+}
+
+func (d HalfCauchy) Quantile(p float64) float64 {
+	if p < 0.5 {
+		return d.Scale * math.Tan(math.Pi/2*p)
+	}
+	return d.Scale / math.Tan(math.Pi/2*(1-p))
+}
@@ -0,0 +1,44 @@
+package dists
+
+import "math"
+
+// HalfNormal is the distribution of |X| for X normally distributed with mean zero and standard deviation Sigma.
+type HalfNormal struct {
+	Sigma float64
+}
+
+func (d HalfNormal) Mode() float64 {
+	return 0.0
+}
+
+func (d HalfNormal) Prob(x float64) float64 {
+	return math.Exp(d.LogProb(x))
+}
+
+func (d HalfNormal) LogProb(x float64) float64 {
+	if x < 0 {
+		return math.Inf(-1)
+	}
+	return math.Ln2 + normalLogProb(x/d.Sigma) - math.Log(d.Sigma)
+}
+
+func (d HalfNormal) CDF(x float64) float64 {
+	if x <= 0 {
+		return 0.0
+	}
+	return math.Erf(x / (d.Sigma * math.Sqrt2))
+}
+
+func (d HalfNormal) Survival(x float64) float64 {
+	if x <= 0 {
+		return 1.0
+	}
+	return math.Erfc(x / (d.Sigma * math.Sqrt2))
+}
+
+func (d HalfNormal) Quantile(p float64) float64 {
+	if p < 0.5 {
+		return d.Sigma * math.Sqrt2 * math.Erfinv(p)
+	}
+	return d.Sigma * math.Sqrt2 * math.Erfcinv(1-p)
+}
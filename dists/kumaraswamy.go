@@ -0,0 +1,70 @@
+package dists
+
+import "math"
+
+// Kumaraswamy is the Kumaraswamy distribution on [0, 1] with shapes A and B, a Beta-like distribution with a closed form CDF.
+type Kumaraswamy struct {
+	A, B float64
+}
+
+// The log of 1-x^A, accurate for x near both 0 and 1.
+func (d Kumaraswamy) log1mxa(x float64) float64 {
+	la := d.A * math.Log(x)
+	if la < -math.Ln2 {
+		return math.Log1p(-math.Exp(la))
+	}
+	return math.Log(-math.Expm1(la))
+}
+
+func (d Kumaraswamy) Mode() float64 {
+	if d.A < 1 || (d.A == 1 && d.B == 1) {
+		return 0.0
+	}
+	if d.B < 1 {
+		return 1.0
+	}
+	return math.Pow((d.A-1)/(d.A*d.B-1), 1/d.A)
+}
+
+func (d Kumaraswamy) Prob(x float64) float64 {
+	return math.Exp(d.LogProb(x))
+}
+
+func (d Kumaraswamy) LogProb(x float64) float64 {
+	if x < 0 || x > 1 {
+		return math.Inf(-1)
+	}
+	l := math.Log(d.A * d.B)
+	// Skip unit shapes, so the ends of the support don't give 0*inf.
+	if d.A != 1 {
+		l += (d.A - 1) * math.Log(x)
+	}
+	if d.B != 1 {
+		l += (d.B - 1) * d.log1mxa(x)
+	}
+	return l
+}
+
+func (d Kumaraswamy) CDF(x float64) float64 {
+	if x <= 0 {
+		return 0.0
+	}
+	if x >= 1 {
+		return 1.0
+	}
+	return -math.Expm1(d.B * d.log1mxa(x))
+}
+
+func (d Kumaraswamy) Survival(x float64) float64 {
+	if x <= 0 {
+		return 1.0
+	}
+	if x >= 1 {
+		return 0.0
+	}
+	return math.Exp(d.B * d.log1mxa(x))
+}
+
+func (d Kumaraswamy) Quantile(p float64) float64 {
+	return math.Exp(math.Log(-math.Expm1(math.Log1p(-p)/d.B)) / d.A)
+}
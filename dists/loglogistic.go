@@ -0,0 +1,58 @@
+package dists
+
+import "math"
+
+// LogLogistic is the distribution of exp(X) for X logistically distributed, with scale Alpha and shape Beta.
+type LogLogistic struct {
+	Alpha, Beta float64
+}
+
+func (d LogLogistic) Mode() float64 {
+	if d.Beta <= 1 {
+		return 0.0
+	}
+	return d.Alpha * math.Pow((d.Beta-1)/(d.Beta+1), 1/d.Beta)
+}
+
+func (d LogLogistic) Prob(x float64) float64 {
+	return math.Exp(d.LogProb(x))
+}
+
+func (d LogLogistic) LogProb(x float64) float64 {
+	if x < 0 {
+		return math.Inf(-1)
+	}
+	logZ := math.Log(x / d.Alpha)
+	l := math.Log(d.Beta/d.Alpha) - 2*log1pExp(d.Beta*logZ)
+	if d.Beta != 1 {
+		// Skip a unit shape, so x = 0 doesn't give 0*inf.
+		l += (d.Beta - 1) * logZ
+	}
+	return l
+}
+
+func (d LogLogistic) CDF(x float64) float64 {
+	if x <= 0 {
+		return 0.0
+	}
+	return 1 / (1 + math.Pow(x/d.Alpha, -d.Beta))
+}
+
+func (d LogLogistic) Survival(x float64) float64 {
+	if x <= 0 {
+		return 1.0
+	}
+	return 1 / (1 + math.Pow(x/d.Alpha, d.Beta))
+}
+
+func (d LogLogistic) Quantile(p float64) float64 {
+	return d.Alpha * math.Pow(p/(1-p), 1/d.Beta)
+}
+
+// log(1+exp(y)), without overflow for large y.
+func log1pExp(y float64) float64 {
+	if y > 0 {
+		return y + math.Log1p(math.Exp(-y))
+	}
+	return math.Log1p(math.Exp(y))
+}
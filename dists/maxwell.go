@@ -0,0 +1,64 @@
+package dists
+
+import "math"
+
+// Maxwell is the Maxwell-Boltzmann distribution of the length of a three dimensional vector, with independent normal components of standard deviation A.
+type Maxwell struct {
+	A float64
+}
+
+func (d Maxwell) Mode() float64 {
+	return math.Sqrt2 * d.A
+}
+
+func (d Maxwell) Prob(x float64) float64 {
+	return math.Exp(d.LogProb(x))
+}
+
+func (d Maxwell) LogProb(x float64) float64 {
+	if x < 0 {
+		return math.Inf(-1)
+	}
+	z := x / d.A
+	return math.Log(sqrt2OverPi*z*z/d.A) - z*z/2
+}
+
+func (d Maxwell) CDF(x float64) float64 {
+	if x <= 0 {
+		return 0.0
+	}
+	z := x / d.A
+	if z < 3 {
+		// erf(z/sqrt2) - sqrt(2/pi)*z*exp(-z^2/2) cancels for small z, so sum the series for the incomplete gamma function P(3/2, z^2/2).
+		h := z * z / 2
+		term, sum := 1.0, 1.0
+		for k := 1; term > 1e-17*sum; k++ {
+			term *= h / (1.5 + float64(k))
+			sum += term
+		}
+		return sqrt2OverPi * z * z * z / 3 * math.Exp(-h) * sum
+	}
+	return math.Erf(z/math.Sqrt2) - sqrt2OverPi*z*math.Exp(-z*z/2)
+}
+
+func (d Maxwell) Survival(x float64) float64 {
+	if x <= 0 {
+		return 1.0
+	}
+	z := x / d.A
+	return math.Erfc(z/math.Sqrt2) + sqrt2OverPi*z*math.Exp(-z*z/2)
+}
+
+func (d Maxwell) Quantile(p float64) float64 {
+	if p <= 0 {
+		return 0.0
+	}
+	if p >= 1 {
+		return math.Inf(1)
+	}
+	hi := d.A
+	for d.Survival(hi) > 1-p {
+		hi *= 2
+	}
+	return invert(d, p, 0, hi)
+}
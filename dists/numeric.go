@@ -0,0 +1,80 @@
+package dists
+
+import "math"
+
+const (
+	logSqrt2Pi  = 0.91893853320467274178032973640561763986139747363778 // log(sqrt(2*pi))
+	sqrt2OverPi = 0.79788456080286535587989211986876373695171726232986 // sqrt(2/pi)
+)
+
+// The standard normal log density.
+func normalLogProb(z float64) float64 {
+	return -z*z/2 - logSqrt2Pi
+}
+
+// The standard normal CDF.
+func normalCDF(z float64) float64 {
+	return math.Erfc(-z/math.Sqrt2) / 2
+}
+
+// The log of the standard normal CDF, accurate far into the lower tail where the CDF underflows.
+func normalLogCDF(z float64) float64 {
+	if z > -30 {
+		return math.Log(normalCDF(z))
+	}
+	// The Mills ratio CDF(z)/density(z) by its continued fraction, which converges quickly for large -z.
+	t := -z
+	r := t
+	for k := 30; k > 0; k-- {
+		r = t + float64(k)/r
+	}
+	return normalLogProb(z) - math.Log(r)
+}
+
+// The standard normal quantile, accurate in both tails.
+func normalQuantile(p float64) float64 {
+	if p < 0.5 {
+		return -math.Sqrt2 * math.Erfcinv(2*p)
+	}
+	return math.Sqrt2 * math.Erfcinv(2*(1-p))
+}
+
+// A distribution whose quantile can be found numerically, given a bracket.
+type invertible interface {
+	Prob(x float64) float64
+	CDF(x float64) float64
+	Survival(x float64) float64
+}
+
+// Find x in [lo, hi] with CDF(x) = p, by Newton's method safeguarded with bisection.
+// The upper tail is inverted through the survival function, so small 1-p stay accurate.
+func invert(d invertible, p, lo, hi float64) float64 {
+	upper := p > 0.5
+	q := 1 - p
+	x := lo + (hi-lo)/2
+	for range 1000 {
+		var f float64
+		if upper {
+			f = q - d.Survival(x)
+		} else {
+			f = d.CDF(x) - p
+		}
+		if f == 0 {
+			return x
+		}
+		if f < 0 {
+			lo = x
+		} else {
+			hi = x
+		}
+		next := x - f/d.Prob(x)
+		if !(next > lo && next < hi) {
+			next = lo + (hi-lo)/2
+		}
+		if math.Abs(next-x) <= 1e-15*math.Abs(x) || next <= lo || next >= hi {
+			return next
+		}
+		x = next
+	}
+	return x
+}
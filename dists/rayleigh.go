@@ -0,0 +1,44 @@
+package dists
+
+import "math"
+
+// Rayleigh is the distribution of the length of a two dimensional vector, with independent normal components of standard deviation Sigma.
+type Rayleigh struct {
+	Sigma float64
+}
+
+func (d Rayleigh) Mode() float64 {
+	return d.Sigma
+}
+
+func (d Rayleigh) Prob(x float64) float64 {
+	return math.Exp(d.LogProb(x))
+}
+
+func (d Rayleigh) LogProb(x float64) float64 {
+	if x < 0 {
+		return math.Inf(-1)
+	}
+	z := x / d.Sigma
+	return math.Log(z/d.Sigma) - z*z/2
+}
+
+func (d Rayleigh) CDF(x float64) float64 {
+	if x <= 0 {
+		return 0.0
+	}
+	z := x / d.Sigma
+	return -math.Expm1(-z * z / 2)
+}
+
+func (d Rayleigh) Survival(x float64) float64 {
+	if x <= 0 {
+		return 1.0
+	}
+	z := x / d.Sigma
+	return math.Exp(-z * z / 2)
+}
+
+func (d Rayleigh) Quantile(p float64) float64 {
+	return d.Sigma * math.Sqrt(-2*math.Log1p(-p))
+}
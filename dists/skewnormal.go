@@ -0,0 +1,103 @@
+package dists
+
+import (
+	"math"
+
+	"github.com/argusdusty/ziggurat/internal/quad"
+)
+
+// SkewNormal is the skew-normal distribution with location Xi, scale Omega and shape Alpha.
+// Its density is 2/Omega*phi(z)*Phi(Alpha*z) for z = (x-Xi)/Omega, where phi and Phi are the standard normal density and CDF.
+type SkewNormal struct {
+	Xi, Omega, Alpha float64
+}
+
+// Owen's T function T(h, a) for a >= 0, the probability of the standard bivariate normal over a wedge.
+func owenT(h, a float64) float64 {
+	// The integrand is negligible beyond u = 40/|h|.
+	if h != 0 {
+		a = min(a, 40/math.Abs(h))
+	}
+	return math.Exp(-h*h/2) / (2 * math.Pi) * quad.Integrate(func(u float64) float64 { return math.Exp(-h*h*u*u/2) / (1 + u*u) }, 0, a)
+}
+
+// The survival function of the standard skew-normal distribution with a >= 0, the sum of two positive terms.
+func skewNormalSurvival(z, a float64) float64 {
+	return math.Erfc(z/math.Sqrt2)/2 + 2*owenT(z, a)
+}
+
+// The CDF of the standard skew-normal distribution with a >= 0.
+func skewNormalCDF(z, a float64) float64 {
+	if z >= 0 || a == 0 {
+		return normalCDF(z) - 2*owenT(z, a)
+	}
+	// In the light lower tail, normalCDF(z) - 2*owenT(z, a) cancels, so integrate the density instead.
+	s := -z
+	return quad.IntegrateAbove(func(t float64) float64 {
+		return 2 * math.Exp(normalLogProb(t)+normalLogCDF(-a*t))
+	}, s, 1/(1+s*(1+a*a)))
+}
+
+// The mode of the standard skew-normal distribution with a >= 0, where the derivative of the log density is zero.
+// It lies in [0, 1], as the derivative is negative at 1.
+func skewNormalMode(a float64) float64 {
+	lo, hi := 0.0, 1.0
+	for {
+		x := lo + (hi-lo)/2
+		if x <= lo || x >= hi {
+			return x
+		}
+		if a*math.Exp(normalLogProb(a*x)-normalLogCDF(a*x)) > x {
+			lo = x
+		} else {
+			hi = x
+		}
+	}
+}
+
+func (d SkewNormal) Mode() float64 {
+	if d.Alpha < 0 {
+		return d.Xi - d.Omega*skewNormalMode(-d.Alpha)
+	}
+	return d.Xi + d.Omega*skewNormalMode(d.Alpha)
+}
+
+func (d SkewNormal) Prob(x float64) float64 {
+	return math.Exp(d.LogProb(x))
+}
+
+func (d SkewNormal) LogProb(x float64) float64 {
+	z := (x - d.Xi) / d.Omega
+	return math.Ln2 + normalLogProb(z) + normalLogCDF(d.Alpha*z) - math.Log(d.Omega)
+}
+
+func (d SkewNormal) CDF(x float64) float64 {
+	z := (x - d.Xi) / d.Omega
+	if d.Alpha < 0 {
+		return skewNormalSurvival(-z, -d.Alpha)
+	}
+	return skewNormalCDF(z, d.Alpha)
+}
+
+func (d SkewNormal) Survival(x float64) float64 {
+	z := (x - d.Xi) / d.Omega
+	if d.Alpha < 0 {
+		return skewNormalCDF(-z, -d.Alpha)
+	}
+	return skewNormalSurvival(z, d.Alpha)
+}
+
+func (d SkewNormal) Quantile(p float64) float64 {
+	if p <= 0 {
+		return math.Inf(-1)
+	}
+	if p >= 1 {
+		return math.Inf(1)
+	}
+	// The quantile lies between those of the normal (Alpha = 0) and half-normal (|Alpha| = inf) distributions.
+	lo, hi := normalQuantile(p), HalfNormal{Sigma: 1}.Quantile(p)
+	if d.Alpha < 0 {
+		lo, hi = -math.Sqrt2*math.Erfcinv(p), normalQuantile(p)
+	}
+	return invert(d, p, d.Xi+d.Omega*lo, d.Xi+d.Omega*hi)
+}
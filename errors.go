@@ -0,0 +1,28 @@
+package ziggurat
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrNotUnimodal         = errors.New("ziggurat: distribution is not unimodal")
+	ErrNonMonotoneSurvival = errors.New("ziggurat: survival function is not monotonically decreasing")
+	ErrInvalidDensity      = errors.New("ziggurat: density is negative, NaN, or infinite")
+	ErrSearchDiverged      = errors.New("ziggurat: search for a strip boundary diverged")
+	ErrInvalidOptions      = errors.New("ziggurat: invalid options")
+	ErrInvalidTables       = errors.New("ziggurat: invalid tables")
+	ErrInvalidExtrema      = errors.New("ziggurat: extrema must be nonempty, finite and strictly increasing")
+	ErrInvalidWeights      = errors.New("ziggurat: invalid mixture weights")
+	ErrInvalidBounds       = errors.New("ziggurat: invalid truncation bounds")
+	ErrInvalidSupport      = errors.New("ziggurat: support must be a nonempty interval")
+	ErrInvalidDistribution = errors.New("ziggurat: distribution panicked, as for parameters out of bounds")
+)
+
+// Recover from a panic in a method of a distribution, such as gonum's for a parameter out of bounds, into *err.
+// Deferred by each constructor, as a bad parameter shouldn't crash the process.
+func recoverDistribution(err *error) {
+	if r := recover(); r != nil {
+		*err = fmt.Errorf("%w: %v", ErrInvalidDistribution, r)
+	}
+}
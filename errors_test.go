@@ -0,0 +1,141 @@
+package ziggurat_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/argusdusty/ziggurat"
+	"gonum.org/v1/gonum/stat/distuv"
+)
+
+// An even mixture of two unit normals at -3 and 3, with the mode misreported at the trough between them.
+type BimodalNormal struct{}
+
+func (B BimodalNormal) Mode() float64 {
+	return 0.0
+}
+
+func (B BimodalNormal) Prob(x float64) float64 {
+	return (distuv.UnitNormal.Prob(x-3) + distuv.UnitNormal.Prob(x+3)) / 2
+}
+
+func (B BimodalNormal) Survival(x float64) float64 {
+	return (distuv.UnitNormal.Survival(x-3) + distuv.UnitNormal.Survival(x+3)) / 2
+}
+
+func (B BimodalNormal) Quantile(p float64) float64 {
+	return math.NaN() // Unused
+}
+
+// A half-normal whose density turns negative in the tail.
+type NegativeDensity struct {
+	UnitHalfNormal
+}
+
+func (N NegativeDensity) Prob(x float64) float64 {
+	if x > 2 {
+		return -N.UnitHalfNormal.Prob(x)
+	}
+	return N.UnitHalfNormal.Prob(x)
+}
+
+// A half-normal whose density is NaN in the tail.
+type NaNDensity struct {
+	UnitHalfNormal
+}
+
+func (N NaNDensity) Prob(x float64) float64 {
+	if x > 2 {
+		return math.NaN()
+	}
+	return N.UnitHalfNormal.Prob(x)
+}
+
+// A half-normal whose survival function oscillates.
+type NonMonotoneSurvival struct {
+	UnitHalfNormal
+}
+
+func (N NonMonotoneSurvival) Survival(x float64) float64 {
+	return N.UnitHalfNormal.Survival(x) * (1 + math.Sin(8*x)/2)
+}
+
+// A distribution whose survival function never decays.
+type DivergentSurvival struct {
+	UnitHalfNormal
+}
+
+func (D DivergentSurvival) Survival(x float64) float64 {
+	return 1
+}
+
+func (D DivergentSurvival) Prob(x float64) float64 {
+	return 0
+}
+
+func TestConstructionErrors(t *testing.T) {
+	for _, tc := range []struct {
+		Name string
+		Dist ziggurat.Distribution
+		Err  error
+	}{
+		{Name: "Bimodal", Dist: BimodalNormal{}, Err: ziggurat.ErrNotUnimodal},
+		{Name: "NegativeDensity", Dist: NegativeDensity{}, Err: ziggurat.ErrInvalidDensity},
+		{Name: "NaNDensity", Dist: NaNDensity{}, Err: ziggurat.ErrInvalidDensity},
+		{Name: "NonMonotoneSurvival", Dist: NonMonotoneSurvival{}, Err: ziggurat.ErrNonMonotoneSurvival},
+		{Name: "DivergentSurvival", Dist: DivergentSurvival{}, Err: ziggurat.ErrSearchDiverged},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			if _, err := ziggurat.NewZiggurat(tc.Dist, nil, ziggurat.Options{}); !errors.Is(err, tc.Err) {
+				t.Errorf("NewZiggurat returned error %v, expected %v", err, tc.Err)
+			}
+			if _, err := ziggurat.NewSymmetricZiggurat(tc.Dist, nil, ziggurat.Options{}); !errors.Is(err, tc.Err) {
+				t.Errorf("NewSymmetricZiggurat returned error %v, expected %v", err, tc.Err)
+			}
+		})
+	}
+}
+
+// A parameter out of bounds, for which gonum panics, must be returned as an error by each constructor.
+func TestInvalidDistribution(t *testing.T) {
+	for _, tc := range []struct {
+		Name string
+		Dist ziggurat.Distribution
+	}{
+		{Name: "GammaAlpha0", Dist: distuv.Gamma{Alpha: 0, Beta: 1}},
+		{Name: "StudentsTNu0", Dist: distuv.StudentsT{Mu: 0, Sigma: 1, Nu: 0}},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			if _, err := ziggurat.NewZiggurat(tc.Dist, nil, ziggurat.Options{}); !errors.Is(err, ziggurat.ErrInvalidDistribution) {
+				t.Errorf("NewZiggurat returned error %v, expected %v", err, ziggurat.ErrInvalidDistribution)
+			}
+			if _, err := ziggurat.NewSymmetricZiggurat(tc.Dist, nil, ziggurat.Options{}); !errors.Is(err, ziggurat.ErrInvalidDistribution) {
+				t.Errorf("NewSymmetricZiggurat returned error %v, expected %v", err, ziggurat.ErrInvalidDistribution)
+			}
+			if _, err := ziggurat.NewZiggurat32(tc.Dist, nil, ziggurat.Options{}); !errors.Is(err, ziggurat.ErrInvalidDistribution) {
+				t.Errorf("NewZiggurat32 returned error %v, expected %v", err, ziggurat.ErrInvalidDistribution)
+			}
+			if _, err := ziggurat.NewTruncatedZiggurat(tc.Dist, 0.5, 1, nil, ziggurat.Options{}); !errors.Is(err, ziggurat.ErrInvalidDistribution) {
+				t.Errorf("NewTruncatedZiggurat returned error %v, expected %v", err, ziggurat.ErrInvalidDistribution)
+			}
+		})
+	}
+}
+
+// Heavy tails, where the density and survival underflow, are valid.
+func TestHeavyTail(t *testing.T) {
+	dist := distuv.StudentsT{Mu: 0.0, Sigma: 1.0, Nu: 0.01}
+	if _, err := ziggurat.NewZiggurat(dist, nil, ziggurat.Options{}); err != nil {
+		t.Errorf("NewZiggurat returned error %v", err)
+	}
+	if _, err := ziggurat.NewSymmetricZiggurat(dist, nil, ziggurat.Options{}); err != nil {
+		t.Errorf("NewSymmetricZiggurat returned error %v", err)
+	}
+}
+
+func TestInvalidOptions(t *testing.T) {
+	if _, err := ziggurat.NewZiggurat(distuv.UnitNormal, nil, ziggurat.Options{Strips: 3}); !errors.Is(err, ziggurat.ErrInvalidOptions) {
+		t.Errorf("NewZiggurat returned error %v, expected %v", err, ziggurat.ErrInvalidOptions)
+	}
+}
@@ -0,0 +1,97 @@
+package ziggurat_test
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"github.com/argusdusty/ziggurat"
+	"github.com/argusdusty/ziggurat/zigsrc"
+	"github.com/argusdusty/ziggurat/zigtest"
+	"github.com/vpxyz/xorshift/xoroshiro128plus"
+	"gonum.org/v1/gonum/stat/distuv"
+)
+
+const (
+	FILL_SAMPLES        = 10_000
+	FILL_POSITION_CALLS = 10_000 // The number of calls to Fill sampling each position.
+	FILL_POSITION_LEN   = 64
+	FILL_POSITION_ALPHA = 0.0001
+)
+
+var FILL_DISTRIBUTIONS = []struct {
+	Name string
+	Dist ziggurat.Distribution
+	Fn   func(ziggurat.Distribution, rand.Source) ziggurat.Sampler
+}{
+	{Name: "Normal", Dist: distuv.UnitNormal, Fn: ziggurat.ToZiggurat},
+	{Name: "SymmetricNormal", Dist: distuv.UnitNormal, Fn: ziggurat.ToSymmetricZiggurat},
+	{Name: "HalfNormal", Dist: UnitHalfNormal{}, Fn: ziggurat.ToZiggurat},
+	{Name: "NegHalfNormal", Dist: NegUnitHalfNormal{}, Fn: ziggurat.ToZiggurat},
+	{Name: "Gamma", Dist: distuv.Gamma{Alpha: 0.5, Beta: 1.0}, Fn: ziggurat.ToZiggurat},
+	{Name: "SymmetricStudentsT", Dist: distuv.StudentsT{Mu: 0.0, Sigma: 1.0, Nu: 1.0}, Fn: ziggurat.ToSymmetricZiggurat},
+}
+
+// Fill must sample the distribution, though not necessarily with the same samples as repeated calls to Rand.
+func TestFill(t *testing.T) {
+	for _, d := range FILL_DISTRIBUTIONS {
+		t.Run(d.Name, func(t *testing.T) {
+			if err := zigtest.Test(d.Dist, d.Fn(d.Dist, xoroshiro128plus.NewSource(1)), zigtest.Options{}); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+// Fill must sample the distribution when drawing random numbers from the source in batches.
+func TestFillBatches(t *testing.T) {
+	for _, d := range FILL_DISTRIBUTIONS {
+		t.Run(d.Name, func(t *testing.T) {
+			if err := zigtest.Test(d.Dist, d.Fn(d.Dist, zigsrc.NewXoshiro256PlusPlus(1)), zigtest.Options{}); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+// The samples at position i of repeated calls to fill with slices of length n.
+func positionSamples(fill func(dst []float64), n, i int) []float64 {
+	var dst = make([]float64, n)
+	var samples = make([]float64, FILL_POSITION_CALLS)
+	for k := range samples {
+		fill(dst)
+		samples[k] = dst[i]
+	}
+	return samples
+}
+
+// Each position of Fill must sample the distribution, so composite samplers must interleave the samples of their parts.
+func TestFillPositions(t *testing.T) {
+	for _, d := range FILL_DISTRIBUTIONS {
+		t.Run(d.Name, func(t *testing.T) {
+			Z := d.Fn(d.Dist, xoroshiro128plus.NewSource(1))
+			for _, i := range []int{0, FILL_POSITION_LEN - 1} {
+				testAndersonDarling(t, positionSamples(Z.Fill, FILL_POSITION_LEN, i), d.Dist, FILL_POSITION_ALPHA)
+			}
+		})
+	}
+}
+
+func BenchmarkFill(b *testing.B) {
+	for _, d := range FILL_DISTRIBUTIONS {
+		b.Run(d.Name, func(b *testing.B) {
+			for _, rng := range []struct {
+				Name string
+				Src  rand.Source
+			}{{Name: "xoroshiro128+", Src: xoroshiro128plus.NewSource(rand.Int64())}, {Name: "zigsrc.Xoroshiro128Plus", Src: zigsrc.NewXoroshiro128Plus(rand.Uint64())}} {
+				b.Run("rng="+rng.Name, func(b *testing.B) {
+					Z := d.Fn(d.Dist, rng.Src)
+					var dst = make([]float64, 1024)
+					for b.Loop() {
+						Z.Fill(dst)
+					}
+					b.ReportMetric(float64(b.Elapsed().Nanoseconds())/float64(b.N*len(dst)), "ns/sample")
+				})
+			}
+		})
+	}
+}
@@ -6,6 +6,9 @@ import (
 	"math/rand/v2"
 	"testing"
 
+	"github.com/argusdusty/ziggurat"
+	"github.com/argusdusty/ziggurat/zigtest"
+	"github.com/vpxyz/xorshift/xoroshiro128plus"
 	"gonum.org/v1/gonum/stat/distuv"
 )
 
@@ -14,9 +17,42 @@ const (
 	GAMMA_SAMPLES = 100_000
 )
 
-// Gamma distributions with alpha < ~0.3 are broken because gonum's GammaIncRegInv can't handle small inputs well.
 // Gamma ~1-5 tends to be finnicky I think because the normal approximation for sampling the moments is not quite right.
-var GAMMA_ALPHAS = []float64{ /*0.01, 0.1,*/ 0.5, 0.9, 1, 2, 5, 100}
+var GAMMA_ALPHAS = []float64{0.001, 0.01, 0.05, 0.1, 0.5, 0.9, 1, 2, 5, 100}
+
+// With small alpha, a noticeable fraction of samples are too small for a float64, and round to zero, which the
+// Anderson-Darling test can't tell apart. Test the log of the samples instead, where below the smallest normal float64
+// the CDF is the leading term (beta*x)^alpha/Gamma(alpha+1) of its series, as gonum's loses its accuracy there.
+type LogGamma struct {
+	distuv.Gamma
+}
+
+func (G LogGamma) Mode() float64 {
+	return math.Log(G.Gamma.Mode())
+}
+
+func (G LogGamma) Prob(y float64) float64 {
+	return math.Exp(y) * G.Gamma.Prob(math.Exp(y))
+}
+
+func (G LogGamma) CDF(y float64) float64 {
+	if y < -1022*math.Ln2 {
+		lg, _ := math.Lgamma(G.Alpha + 1)
+		return math.Exp(G.Alpha*(y+math.Log(G.Beta)) - lg)
+	}
+	return G.Gamma.CDF(math.Exp(y))
+}
+
+func (G LogGamma) Survival(y float64) float64 {
+	if y < -1022*math.Ln2 {
+		return 1 - G.CDF(y)
+	}
+	return G.Gamma.Survival(math.Exp(y))
+}
+
+func (G LogGamma) Quantile(p float64) float64 {
+	return math.Log(G.Gamma.Quantile(p))
+}
 
 func TestGamma(t *testing.T) {
 	for _, alpha := range GAMMA_ALPHAS {
@@ -26,11 +62,87 @@ func TestGamma(t *testing.T) {
 				lam, _ := math.Lgamma(alpha + m)
 				return math.Exp(lam - la)
 			}
-			testAsymmetricDistribution(t, distuv.Gamma{Alpha: alpha, Beta: 1.0}, func(m uint64) float64 { return momentFn(float64(m)) }, 4, GAMMA_SAMPLES, GAMMA_ALPHA)
+			dist := distuv.Gamma{Alpha: alpha, Beta: 1.0}
+			Z := ziggurat.ToZiggurat(dist, xoroshiro128plus.NewSource(1))
+			samples := make([]float64, GAMMA_SAMPLES)
+			for i := range samples {
+				samples[i] = Z.Rand()
+			}
+			testMoments(t, samples, func(m uint64) float64 { return momentFn(float64(m)) }, 4, GAMMA_ALPHA)
+			// Below half the smallest float64, where samples round to zero, the log density is exponential with rate
+			// alpha, so the log of each sample that rounded to zero is drawn again from it.
+			src := rand.New(rand.NewPCG(1, 2))
+			for i, x := range samples {
+				if x == 0 {
+					samples[i] = -1075*math.Ln2 - src.ExpFloat64()/alpha
+				} else if x < 0x1p-1022 {
+					// math.Log loses its accuracy for subnormals.
+					samples[i] = math.Log(x*0x1p64) - 64*math.Ln2
+				} else {
+					samples[i] = math.Log(x)
+				}
+			}
+			testAndersonDarling(t, samples, LogGamma{dist}, GAMMA_ALPHA)
 		})
 	}
 }
 
+// With alpha=1e-3, the splits of the strips near the peak underflow, and those strips share the peak.
+func TestGammaUnderflowedSplits(t *testing.T) {
+	dist := distuv.Gamma{Alpha: 0.001, Beta: 1}
+	for _, c := range []struct {
+		Name string
+		Fn   func() (ziggurat.Sampler, error)
+	}{
+		{Name: "Default", Fn: func() (ziggurat.Sampler, error) { return ziggurat.NewZiggurat(dist, nil, ziggurat.Options{}) }},
+		{Name: "Symmetric", Fn: func() (ziggurat.Sampler, error) { return ziggurat.NewSymmetricZiggurat(dist, nil, ziggurat.Options{}) }},
+		{Name: "Truncated", Fn: func() (ziggurat.Sampler, error) {
+			return ziggurat.NewTruncatedZiggurat(dist, 0, 1, nil, ziggurat.Options{})
+		}},
+	} {
+		t.Run("construction="+c.Name, func(t *testing.T) {
+			Z, err := c.Fn()
+			if err != nil {
+				t.Fatalf("Constructor returned error %v", err)
+			}
+			if s := Z.Stats(); s.MaxStripAreaError > 1e-9 {
+				t.Errorf("Strips have area error %v", s.MaxStripAreaError)
+			}
+		})
+	}
+	if _, err := ziggurat.NewZiggurat32(dist, nil, ziggurat.Options{}); err != nil {
+		t.Errorf("NewZiggurat32 returned error %v", err)
+	}
+	t.Run("tables", func(t *testing.T) {
+		testTablesRoundTrip(t, ziggurat.ToZiggurat(dist, xoroshiro128plus.NewSource(1)), dist, (*ziggurat.Tables).MarshalBinary, func(data []byte, tables *ziggurat.Tables) error { return tables.UnmarshalBinary(data) })
+	})
+}
+
+// A Gamma distribution whose density, computed directly from its formula, overflows for large alpha and beta, while its
+// log density, from gonum, doesn't.
+type DirectGamma struct {
+	distuv.Gamma
+}
+
+func (G DirectGamma) Prob(x float64) float64 {
+	return math.Pow(G.Beta, G.Alpha) * math.Pow(x, G.Alpha-1) * math.Exp(-G.Beta*x) / math.Gamma(G.Alpha)
+}
+
+// The tables must be built from the log density where it is available.
+func TestGammaLogProb(t *testing.T) {
+	dist := DirectGamma{distuv.Gamma{Alpha: 100, Beta: 1e4}}
+	if p := dist.Prob(dist.Mode()); !math.IsNaN(p) && !math.IsInf(p, 0) {
+		t.Fatalf("Density at the mode is %v, which doesn't overflow", p)
+	}
+	Z, err := ziggurat.NewZiggurat(dist, xoroshiro128plus.NewSource(1), ziggurat.Options{})
+	if err != nil {
+		t.Fatalf("NewZiggurat returned error %v", err)
+	}
+	if err := zigtest.Test(dist.Gamma, Z, zigtest.Options{}); err != nil {
+		t.Error(err)
+	}
+}
+
 func BenchmarkGamma(b *testing.B) {
 	for _, alpha := range GAMMA_ALPHAS {
 		b.Run(fmt.Sprintf("alpha=%v", alpha), func(b *testing.B) {
@@ -0,0 +1,123 @@
+package ziggurat
+
+import (
+	"fmt"
+	"math"
+)
+
+// A GonumDistribution is a Distribution without a Mode method, such as some of gonum's distuv types.
+type GonumDistribution interface {
+	Prob(x float64) float64
+	Survival(x float64) float64
+	Quantile(p float64) float64
+}
+
+// A GonumDistribution, with the mode found numerically.
+type gonumDistribution struct {
+	GonumDistribution
+	lo, hi, mode float64
+}
+
+// FromGonum returns a Distribution for d, so it can be passed to ToZiggurat, with the support from Quantile(0) and Quantile(1).
+// The mode of its density, which must be unimodal, is bracketed by the sign of the slope of the log density, as in searchFloat.
+// The LogProb and CDF methods of d are used, if it has them.
+func FromGonum(d GonumDistribution) (_ Distribution, err error) {
+	defer recoverDistribution(&err)
+	lo, hi := d.Quantile(0), d.Quantile(1)
+	if math.IsNaN(lo) {
+		lo = math.Inf(-1)
+	}
+	if math.IsNaN(hi) {
+		hi = math.Inf(1)
+	}
+	if !(lo < hi) {
+		return nil, fmt.Errorf("%w: [%v, %v]", ErrInvalidSupport, lo, hi)
+	}
+	g := gonumDistribution{GonumDistribution: d, lo: lo, hi: hi}
+	// Search the log density, which doesn't underflow far from the mode, for the first point past which it decreases.
+	// The slope is taken over a step relative to the spread of d, so it isn't lost to rounding near a mode at zero.
+	spread := d.Quantile(0.75) - d.Quantile(0.25)
+	if !(spread > 0) || math.IsInf(spread, 0) {
+		spread = 1.0
+	}
+	mode, err := searchFloat(func(x float64) bool {
+		if x <= lo || x >= hi {
+			return x >= hi
+		}
+		h := max(math.Abs(x), spread) * 0x1p-26
+		return g.LogProb(x+h) <= g.LogProb(x-h)
+	})
+	if err != nil {
+		return nil, err
+	}
+	g.mode = mode
+	// Where the density is flat, as for Uniform, prefer an end of the support, which needs only one ziggurat.
+	for _, end := range []float64{lo, hi} {
+		if !math.IsInf(end, 0) && g.LogProb(end) >= g.LogProb(g.mode) {
+			g.mode = end
+		}
+	}
+	if math.IsNaN(g.mode) || math.IsInf(g.mode, 0) {
+		return nil, fmt.Errorf("%w: mode is %v, with support [%v, %v]", ErrNotUnimodal, g.mode, lo, hi)
+	}
+	return g, nil
+}
+
+func (d gonumDistribution) Mode() float64 {
+	return d.mode
+}
+
+// Outside the support, gonum's distributions may return NaN, such as LogNormal for negative x.
+func (d gonumDistribution) outside(x float64) bool {
+	return x < d.lo || x > d.hi
+}
+
+// Some densities are also NaN at the ends of their support, such as LogNormal at 0, where they should vanish.
+func (d gonumDistribution) Prob(x float64) float64 {
+	if d.outside(x) {
+		return 0.0
+	}
+	p := d.GonumDistribution.Prob(x)
+	if math.IsNaN(p) && (x == d.lo || x == d.hi) {
+		return 0.0
+	}
+	return p
+}
+
+func (d gonumDistribution) LogProb(x float64) float64 {
+	l, ok := d.GonumDistribution.(interface{ LogProb(x float64) float64 })
+	if !ok {
+		return math.Log(d.Prob(x))
+	}
+	if d.outside(x) {
+		return math.Inf(-1)
+	}
+	lp := l.LogProb(x)
+	if math.IsNaN(lp) && (x == d.lo || x == d.hi) {
+		return math.Inf(-1)
+	}
+	return lp
+}
+
+func (d gonumDistribution) Survival(x float64) float64 {
+	if x < d.lo {
+		return 1.0
+	}
+	if x > d.hi {
+		return 0.0
+	}
+	return d.GonumDistribution.Survival(x)
+}
+
+func (d gonumDistribution) CDF(x float64) float64 {
+	if x < d.lo {
+		return 0.0
+	}
+	if x > d.hi {
+		return 1.0
+	}
+	if c, ok := d.GonumDistribution.(cdfDistribution); ok {
+		return c.CDF(x)
+	}
+	return 1 - d.GonumDistribution.Survival(x)
+}
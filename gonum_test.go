@@ -0,0 +1,67 @@
+package ziggurat_test
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/argusdusty/ziggurat"
+	"gonum.org/v1/gonum/stat/distuv"
+)
+
+const (
+	GONUM_ALPHA   = 0.0001
+	GONUM_SAMPLES = 100_000
+)
+
+var GONUM_DISTRIBUTIONS = []struct {
+	Dist      ziggurat.GonumDistribution
+	Mode      float64 // NaN if any point of the support is a mode.
+	Moment    func(m uint64) float64
+	MaxMoment uint64
+}{
+	{distuv.LogNormal{Mu: 0, Sigma: 0.5}, math.Exp(-0.25), func(m uint64) float64 { return math.Exp(float64(m*m) * 0.25 / 2) }, 4},
+	{distuv.Weibull{K: 2, Lambda: 1}, math.Sqrt(0.5), func(m uint64) float64 { return math.Gamma(1 + float64(m)/2) }, 4},
+	// An infinite peak at zero.
+	{distuv.Weibull{K: 0.5, Lambda: 1}, 0, func(m uint64) float64 { return math.Gamma(1 + 2*float64(m)) }, 2},
+	// The density jumps to its peak at the start of the support.
+	{distuv.Pareto{Xm: 1, Alpha: 10}, 1, func(m uint64) float64 { return 10 / (10 - float64(m)) }, 4},
+	// Uniform has no Mode method.
+	{distuv.Uniform{Min: 0, Max: 1}, math.NaN(), func(m uint64) float64 { return 1 / float64(m+1) }, 4},
+	{distuv.Gamma{Alpha: 3, Beta: 2}, 1, func(m uint64) float64 {
+		lam, _ := math.Lgamma(3 + float64(m))
+		la, _ := math.Lgamma(3)
+		return math.Exp(lam-la) / math.Pow(2, float64(m))
+	}, 4},
+}
+
+func TestFromGonum(t *testing.T) {
+	for _, test := range GONUM_DISTRIBUTIONS {
+		t.Run(fmt.Sprintf("%T%+v", test.Dist, test.Dist), func(t *testing.T) {
+			dist, err := ziggurat.FromGonum(test.Dist)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !math.IsNaN(test.Mode) && math.Abs(dist.Mode()-test.Mode) > 1e-6*max(1, math.Abs(test.Mode)) {
+				t.Errorf("Mode() = %v, want %v", dist.Mode(), test.Mode)
+			}
+			testAsymmetricDistribution(t, dist, test.Moment, test.MaxMoment, GONUM_SAMPLES, GONUM_ALPHA)
+		})
+	}
+}
+
+// A density with its support reversed.
+type EmptySupport struct {
+	distuv.Normal
+}
+
+func (E EmptySupport) Quantile(p float64) float64 {
+	return -E.Normal.Quantile(p)
+}
+
+func TestInvalidGonum(t *testing.T) {
+	if _, err := ziggurat.FromGonum(EmptySupport{distuv.UnitNormal}); !errors.Is(err, ziggurat.ErrInvalidSupport) {
+		t.Errorf("FromGonum(EmptySupport) error = %v, want %v", err, ziggurat.ErrInvalidSupport)
+	}
+}
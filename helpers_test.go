@@ -3,57 +3,20 @@ package ziggurat_test
 import (
 	"math"
 	"math/rand/v2"
-	"sort"
 	"testing"
 
 	"github.com/argusdusty/ziggurat"
+	"github.com/argusdusty/ziggurat/zigsrc"
+	"github.com/argusdusty/ziggurat/zigtest"
 	"github.com/vpxyz/xorshift/xoroshiro128plus"
 	"gonum.org/v1/gonum/stat/distuv"
 )
 
-func andersonDarlingPValue(A2, n float64) float64 {
-	g1 := func(x float64) float64 {
-		return math.Sqrt(x) * (1 - x) * (49*x - 102)
-	}
-	g2 := func(x float64) float64 {
-		return -0.00022633 + (6.54034-(14.6538-(14.458-(8.259-1.91864*x)*x)*x)*x)*x
-	}
-	g3 := func(x float64) float64 {
-		return -130.2137 + (745.2337-(1705.091-(1950.646-(1116.360-255.7844*x)*x)*x)*x)*x
-	}
-	var y float64
-	if A2 < 2.0 {
-		y = math.Exp(-1.2337141/A2) * (2.00012 + (0.247105-(0.0649821-(0.0347962-(.0116720-0.00168691*A2)*A2)*A2)*A2)*A2) / math.Sqrt(A2)
-	} else {
-		y = math.Exp(-math.Exp(1.0776 - (2.30695-(.43424-(0.082433-(0.008056-.0003146*A2)*A2)*A2)*A2)*A2))
-	}
-	pv := y
-	if y > 0.8 {
-		pv += g3(y) / n
-	} else {
-		c := 0.01265 + 0.1757/n
-		if y < c {
-			pv += (((0.0037/n+0.00078)/n + 0.00006) / n) * g1(y/c)
-		} else {
-			pv += (0.04213 + 0.01365/n) / n * g2((y-c)/(0.8-c))
-		}
-	}
-	return 1 - pv
-}
-
 // False positive rate 2*alpha.
-func testAndersonDarling(t *testing.T, samples []float64, logCDF, logSF func(x float64) float64, alpha float64) {
-	n := float64(len(samples))
-	A2 := float64(-n)
-	sort.Float64s(samples)
-	for i := 1; i <= len(samples); i++ {
-		lcdfz := logCDF(samples[i-1])
-		lsfz := logSF(samples[len(samples)-i])
-		A2 -= float64(i+i-1) / n * (lcdfz + lsfz)
-	}
-	pAD := andersonDarlingPValue(A2, n)
-	if pAD < alpha || pAD > (1-alpha) {
-		t.Errorf("%s distribution random variate with %d samples produced incorrect distribution with Anderson-Darling p-value of %v - A^2=%v", t.Name(), len(samples), pAD, A2)
+func testAndersonDarling(t *testing.T, samples []float64, dist ziggurat.Distribution, alpha float64) {
+	r := zigtest.AndersonDarling(samples, dist)
+	if r.P < alpha || r.P > (1-alpha) {
+		t.Errorf("%s distribution random variate with %d samples produced incorrect distribution with Anderson-Darling p-value of %v - A^2=%v", t.Name(), len(samples), r.P, r.Statistic)
 	}
 }
 
@@ -63,17 +26,15 @@ func testMoment(t *testing.T, samples []float64, M uint64, EXM, EXM2, alpha floa
 	if EXM2 <= EXM*EXM {
 		t.Errorf("Invalid moments for use with testMoment: E[X^M]=%v, E[X^(2*M)]=%v", EXM, EXM2)
 	}
-	N := float64(len(samples))
-	var SM float64
 	for i := range samples {
 		if math.IsNaN(samples[i]) {
 			t.Fatalf("%s distribution random variate with %d samples produced NaN sample at index %d", t.Name(), len(samples), i)
 		}
-		SM += math.Pow(samples[i], float64(M))
 	}
-	pEXM := distuv.Normal{Mu: EXM, Sigma: math.Sqrt((EXM2 - EXM*EXM) / N)}.CDF(SM / N)
-	if pEXM < alpha || pEXM > (1-alpha) || (pEXM > (0.5-alpha)) && (pEXM < (0.5+alpha)) {
-		t.Errorf("%s distribution random variate with %d samples produced incorrect E[X^%d] value: %v (expected %v with sigma %v) which has a p-value of %v", t.Name(), len(samples), M, SM/N, EXM, math.Sqrt((EXM2-EXM*EXM)/N), pEXM)
+	// The p-value is two-sided, so this rejects both tails at alpha, and a mean suspiciously close to E[X^M].
+	r := zigtest.Moment(samples, M, EXM, EXM2)
+	if r.P < 2*alpha || r.P > 1-2*alpha {
+		t.Errorf("%s distribution random variate with %d samples produced incorrect E[X^%d] value: %v (expected %v with sigma %v) which has a p-value of %v", t.Name(), len(samples), M, r.Statistic, EXM, math.Sqrt((EXM2-EXM*EXM)/float64(len(samples))), r.P)
 	}
 }
 
@@ -85,7 +46,7 @@ func testMoments(t *testing.T, samples []float64, momentFn func(m uint64) float6
 	}
 }
 
-func testDistribution(t *testing.T, dist ziggurat.Distribution, momentFn func(m uint64) float64, maxMoment uint64, numSamples uint64, alpha float64, zigguratFn func(dist ziggurat.Distribution, src rand.Source) distuv.Rander, src rand.Source) {
+func testDistribution(t *testing.T, dist ziggurat.Distribution, momentFn func(m uint64) float64, maxMoment uint64, numSamples uint64, alpha float64, zigguratFn func(dist ziggurat.Distribution, src rand.Source) ziggurat.Sampler, src rand.Source) {
 	Z := zigguratFn(dist, src)
 
 	var samples = make([]float64, numSamples)
@@ -94,10 +55,10 @@ func testDistribution(t *testing.T, dist ziggurat.Distribution, momentFn func(m
 	}
 
 	testMoments(t, samples[:], momentFn, maxMoment, alpha)
-	testAndersonDarling(t, samples[:], func(x float64) float64 { return math.Log1p(-dist.Survival(x)) }, func(x float64) float64 { return math.Log(dist.Survival(x)) }, alpha)
+	testAndersonDarling(t, samples[:], dist, alpha)
 }
 
-func testDistributionAllRngs(t *testing.T, dist ziggurat.Distribution, momentFn func(m uint64) float64, maxMoment uint64, numSamples uint64, alpha float64, zigguratFn func(dist ziggurat.Distribution, src rand.Source) distuv.Rander) {
+func testDistributionAllRngs(t *testing.T, dist ziggurat.Distribution, momentFn func(m uint64) float64, maxMoment uint64, numSamples uint64, alpha float64, zigguratFn func(dist ziggurat.Distribution, src rand.Source) ziggurat.Sampler) {
 	for _, rng := range []struct {
 		Name string
 		Src  rand.Source
@@ -115,7 +76,7 @@ func testAsymmetricDistribution(t *testing.T, dist ziggurat.Distribution, moment
 func testSymmetricDistribution(t *testing.T, dist ziggurat.Distribution, momentFn func(m uint64) float64, maxMoment uint64, numSamples uint64, alpha float64) {
 	var zigguratFns = []struct {
 		Name string
-		Fn   func(ziggurat.Distribution, rand.Source) distuv.Rander
+		Fn   func(ziggurat.Distribution, rand.Source) ziggurat.Sampler
 	}{{Name: "Default", Fn: ziggurat.ToZiggurat}, {Name: "Symmetric", Fn: ziggurat.ToSymmetricZiggurat}}
 
 	for _, zigguratFn := range zigguratFns {
@@ -135,7 +96,7 @@ func benchmarkDistributionAllRngs(b *testing.B, distributionFn func(rand.Source)
 	for _, rng := range []struct {
 		Name string
 		Src  rand.Source
-	}{{Name: "Default", Src: nil}, {Name: "xoroshiro128+", Src: xoroshiro128plus.NewSource(rand.Int64())}} {
+	}{{Name: "Default", Src: nil}, {Name: "xoroshiro128+", Src: xoroshiro128plus.NewSource(rand.Int64())}, {Name: "zigsrc.Xoroshiro128Plus", Src: zigsrc.NewXoroshiro128Plus(rand.Uint64())}} {
 		b.Run("rng="+rng.Name, func(b *testing.B) {
 			distribution := distributionFn(rng.Src)
 			benchmarkDistribution(b, distribution)
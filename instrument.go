@@ -0,0 +1,154 @@
+package ziggurat
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand/v2"
+	"sync/atomic"
+)
+
+// Counters is a snapshot of the counts of the branches taken by an instrumented sampler.
+type Counters struct {
+	Samples        uint64 // The samples drawn.
+	FastPath       uint64 // The samples accepted on the fast path, including after rejections from a wedge.
+	WedgeAccepts   uint64 // The samples accepted from a wedge, each evaluating the density.
+	WedgeRejects   uint64 // The points rejected from a wedge, each evaluating the density, after which the strip is sampled again.
+	Tail           uint64 // The samples drawn from the infinite tail, by its envelope or Quantile.
+	Peak           uint64 // The samples drawn from the infinite peak.
+	PeakIterations uint64 // The iterations of the infinite peak's rejection loop.
+}
+
+type counter int
+
+const (
+	counterSamples counter = iota
+	counterWedgeAccepts
+	counterWedgeRejects
+	counterTail
+	counterPeak
+	counterPeakIterations
+	numCounters
+)
+
+// The live counts of an instrumented sampler, shared by its clones. The fast path isn't counted, as it is inferred from the others.
+type counters [numCounters]atomic.Uint64
+
+// Increment a counter. Uninstrumented samplers have nil counters, and only check for them off the fast path.
+func (c *counters) inc(k counter) {
+	if c != nil {
+		c[k].Add(1)
+	}
+}
+
+// An InstrumentedSampler counts the branches taken by a Sampler, for diagnosing a slow sampler.
+// It implements expvar.Var, so its counts can be published with expvar.Publish.
+type InstrumentedSampler struct {
+	Sampler
+	c *counters
+}
+
+// Instrument returns a copy of s which counts the branches it takes, sharing its tables and random source.
+// Samplers without instrumentation are unaffected, so only the instrumented copy pays for counting.
+// Clones and location-scale views of the instrumented sampler add to the same counts, safely across goroutines.
+func Instrument(s Sampler) (*InstrumentedSampler, error) {
+	c := new(counters)
+	i, err := instrument(s, c)
+	if err != nil {
+		return nil, err
+	}
+	return &InstrumentedSampler{Sampler: i, c: c}, nil
+}
+
+func instrument(s Sampler, c *counters) (Sampler, error) {
+	switch z := s.(type) {
+	case *ziggurat:
+		v := *z
+		v.counters = c
+		return &v, nil
+	case symmetricZiggurat:
+		r, err := instrument(z.r, c)
+		if err != nil {
+			return nil, err
+		}
+		return symmetricZiggurat{r: r.(*ziggurat)}, nil
+	case *flippedZiggurat:
+		r, err := instrument(z.Sampler, c)
+		if err != nil {
+			return nil, err
+		}
+		return &flippedZiggurat{Sampler: r, mode: z.mode}, nil
+	case *twoPartZiggurat:
+		left, err := instrument(z.leftSide, c)
+		if err != nil {
+			return nil, err
+		}
+		right, err := instrument(z.rightSide, c)
+		if err != nil {
+			return nil, err
+		}
+		return &twoPartZiggurat{rightSideProb: z.rightSideProb, leftSide: left, rightSide: right, src: z.src}, nil
+	case *mixtureZiggurat:
+		parts := make([]Sampler, len(z.parts))
+		for i, part := range z.parts {
+			var err error
+			if parts[i], err = instrument(part, c); err != nil {
+				return nil, err
+			}
+		}
+		return &mixtureZiggurat{alias: z.alias, parts: parts, src: z.src}, nil
+	case *InstrumentedSampler:
+		return instrument(z.Sampler, c)
+	}
+	return nil, fmt.Errorf("ziggurat: cannot instrument %T", s)
+}
+
+func (s *InstrumentedSampler) Rand() float64 {
+	s.c[counterSamples].Add(1)
+	return s.Sampler.Rand()
+}
+
+func (s *InstrumentedSampler) Fill(dst []float64) {
+	s.c[counterSamples].Add(uint64(len(dst)))
+	s.Sampler.Fill(dst)
+}
+
+func (s *InstrumentedSampler) WithLocationScale(loc, scale float64) Sampler {
+	return &InstrumentedSampler{Sampler: s.Sampler.WithLocationScale(loc, scale), c: s.c}
+}
+
+func (s *InstrumentedSampler) Clone(src rand.Source) Sampler {
+	return &InstrumentedSampler{Sampler: s.Sampler.Clone(src), c: s.c}
+}
+
+// Counters returns a snapshot of the counts. Samples still being drawn on other goroutines may be partly counted.
+func (s *InstrumentedSampler) Counters() Counters {
+	c := Counters{
+		Samples:        s.c[counterSamples].Load(),
+		WedgeAccepts:   s.c[counterWedgeAccepts].Load(),
+		WedgeRejects:   s.c[counterWedgeRejects].Load(),
+		Tail:           s.c[counterTail].Load(),
+		Peak:           s.c[counterPeak].Load(),
+		PeakIterations: s.c[counterPeakIterations].Load(),
+	}
+	// Every sample not accepted by another branch took the fast path.
+	if slow := c.WedgeAccepts + c.Tail + c.Peak; c.Samples > slow {
+		c.FastPath = c.Samples - slow
+	}
+	return c
+}
+
+// Reset sets the counts to zero.
+func (s *InstrumentedSampler) Reset() {
+	for i := range s.c {
+		s.c[i].Store(0)
+	}
+}
+
+// String returns the counts as a JSON object, implementing expvar.Var.
+func (s *InstrumentedSampler) String() string {
+	b, err := json.Marshal(s.Counters())
+	if err != nil {
+		panic(err)
+	}
+	return string(b)
+}
@@ -0,0 +1,77 @@
+package ziggurat_test
+
+import (
+	"encoding/json"
+	"expvar"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/argusdusty/ziggurat"
+	"gonum.org/v1/gonum/stat/distuv"
+)
+
+const INSTRUMENT_SAMPLES = 1_000_000
+
+// The counts must add up, match the rates predicted by Stats, and not change the samples drawn.
+func TestInstrument(t *testing.T) {
+	for _, d := range STATS_DISTRIBUTIONS {
+		t.Run(d.Name, func(t *testing.T) {
+			opts := ziggurat.Options{Strips: 16}
+			var calls int
+			dist := countedDistribution{d.Dist, &calls}
+			I, err := ziggurat.Instrument(d.Fn(dist, rand.NewPCG(1, 2), opts))
+			if err != nil {
+				t.Fatal(err)
+			}
+			Z := d.Fn(dist, rand.NewPCG(1, 2), opts)
+			for i := range INSTRUMENT_SAMPLES {
+				if want, got := Z.Rand(), I.Rand(); got != want {
+					t.Fatalf("Instrumented sample %d = %v, want %v", i, got, want)
+				}
+			}
+			c, stats := I.Counters(), I.Stats()
+			if c.Samples != INSTRUMENT_SAMPLES || c.FastPath+c.WedgeAccepts+c.Tail+c.Peak != c.Samples {
+				t.Errorf("Counters %+v don't add up to %d samples", c, INSTRUMENT_SAMPLES)
+			}
+			for _, rate := range []struct {
+				Name            string
+				Count, Expected float64
+			}{
+				{"Tail", float64(c.Tail), stats.Tail},
+				{"Peak", float64(c.Peak), stats.Peak},
+			} {
+				// Allow for the sampling error of the count, which is roughly Poisson.
+				expected := rate.Expected * INSTRUMENT_SAMPLES
+				if math.Abs(rate.Count-expected) > 5*math.Sqrt(expected)+1 {
+					t.Errorf("%s count %v, expected %v", rate.Name, rate.Count, expected)
+				}
+			}
+			if (c.PeakIterations > 0) != (c.Peak > 0) {
+				t.Errorf("Counted %d peak iterations for %d peak samples", c.PeakIterations, c.Peak)
+			}
+			I.Reset()
+			if c := I.Counters(); c != (ziggurat.Counters{}) {
+				t.Errorf("Counters after Reset = %+v", c)
+			}
+		})
+	}
+}
+
+// Clones must add to the counts of the sampler they're cloned from, which are published by expvar.
+func TestInstrumentExpvar(t *testing.T) {
+	I, err := ziggurat.Instrument(ziggurat.ToZigguratWithOptions(distuv.StudentsT{Mu: 0, Sigma: 1, Nu: 1}, rand.NewPCG(1, 2), ziggurat.Options{Strips: 16}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expvar.Publish("TestInstrumentExpvar", I)
+	I.Fill(make([]float64, FILL_SAMPLES))
+	I.Clone(rand.NewPCG(3, 4)).WithLocationScale(1, 2).Fill(make([]float64, FILL_SAMPLES))
+	var c ziggurat.Counters
+	if err := json.Unmarshal([]byte(expvar.Get("TestInstrumentExpvar").String()), &c); err != nil {
+		t.Fatal(err)
+	}
+	if c != I.Counters() || c.Samples != 2*FILL_SAMPLES || c.Tail == 0 || c.WedgeAccepts == 0 || c.WedgeRejects == 0 {
+		t.Errorf("Published counters %+v, want %+v", c, I.Counters())
+	}
+}
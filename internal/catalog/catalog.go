@@ -0,0 +1,179 @@
+// Package catalog names the built-in distributions available to the ziggurat command line tools.
+package catalog
+
+import (
+	"flag"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/argusdusty/ziggurat"
+	"gonum.org/v1/gonum/stat/distuv"
+)
+
+// A Param is a named parameter of a distribution. A NaN Default marks the parameter as required.
+type Param struct {
+	Name    string
+	Default float64
+}
+
+// An Entry describes how to construct a distribution from its parameters, given in the order of Params.
+type Entry struct {
+	Name   string
+	Params []Param
+	// Reports whether the distribution is symmetric about its mode, so a symmetric ziggurat can be used.
+	Symmetric func(p []float64) bool
+	New       func(p []float64) ziggurat.Distribution
+	// A Go expression, using the gonum.org/v1/gonum/stat/distuv package, which constructs the distribution.
+	GoExpr func(p []float64) string
+}
+
+func always(p []float64) bool { return true }
+func never(p []float64) bool  { return false }
+
+var required = math.NaN()
+
+var entries = []Entry{
+	{
+		Name:      "beta",
+		Params:    []Param{{"alpha", required}, {"beta", required}},
+		Symmetric: func(p []float64) bool { return p[0] == p[1] },
+		New:       func(p []float64) ziggurat.Distribution { return distuv.Beta{Alpha: p[0], Beta: p[1]} },
+		GoExpr:    func(p []float64) string { return fmt.Sprintf("distuv.Beta{Alpha: %v, Beta: %v}", p[0], p[1]) },
+	},
+	{
+		Name:      "chisquared",
+		Params:    []Param{{"k", required}},
+		Symmetric: never,
+		New:       func(p []float64) ziggurat.Distribution { return distuv.ChiSquared{K: p[0]} },
+		GoExpr:    func(p []float64) string { return fmt.Sprintf("distuv.ChiSquared{K: %v}", p[0]) },
+	},
+	{
+		Name:      "exponential",
+		Params:    []Param{{"rate", 1}},
+		Symmetric: never,
+		New:       func(p []float64) ziggurat.Distribution { return distuv.Exponential{Rate: p[0]} },
+		GoExpr:    func(p []float64) string { return fmt.Sprintf("distuv.Exponential{Rate: %v}", p[0]) },
+	},
+	{
+		Name:      "gamma",
+		Params:    []Param{{"alpha", required}, {"beta", 1}},
+		Symmetric: never,
+		New:       func(p []float64) ziggurat.Distribution { return distuv.Gamma{Alpha: p[0], Beta: p[1]} },
+		GoExpr:    func(p []float64) string { return fmt.Sprintf("distuv.Gamma{Alpha: %v, Beta: %v}", p[0], p[1]) },
+	},
+	{
+		Name:      "gumbel",
+		Params:    []Param{{"mu", 0}, {"beta", 1}},
+		Symmetric: never,
+		New:       func(p []float64) ziggurat.Distribution { return distuv.GumbelRight{Mu: p[0], Beta: p[1]} },
+		GoExpr:    func(p []float64) string { return fmt.Sprintf("distuv.GumbelRight{Mu: %v, Beta: %v}", p[0], p[1]) },
+	},
+	{
+		Name:      "laplace",
+		Params:    []Param{{"mu", 0}, {"scale", 1}},
+		Symmetric: always,
+		New:       func(p []float64) ziggurat.Distribution { return distuv.Laplace{Mu: p[0], Scale: p[1]} },
+		GoExpr:    func(p []float64) string { return fmt.Sprintf("distuv.Laplace{Mu: %v, Scale: %v}", p[0], p[1]) },
+	},
+	{
+		Name:      "logistic",
+		Params:    []Param{{"mu", 0}, {"scale", 1}},
+		Symmetric: always,
+		New:       func(p []float64) ziggurat.Distribution { return distuv.Logistic{Mu: p[0], S: p[1]} },
+		GoExpr:    func(p []float64) string { return fmt.Sprintf("distuv.Logistic{Mu: %v, S: %v}", p[0], p[1]) },
+	},
+	{
+		Name:      "normal",
+		Params:    []Param{{"mu", 0}, {"sigma", 1}},
+		Symmetric: always,
+		New:       func(p []float64) ziggurat.Distribution { return distuv.Normal{Mu: p[0], Sigma: p[1]} },
+		GoExpr:    func(p []float64) string { return fmt.Sprintf("distuv.Normal{Mu: %v, Sigma: %v}", p[0], p[1]) },
+	},
+	{
+		Name:      "studentst",
+		Params:    []Param{{"nu", required}, {"mu", 0}, {"sigma", 1}},
+		Symmetric: always,
+		New:       func(p []float64) ziggurat.Distribution { return distuv.StudentsT{Nu: p[0], Mu: p[1], Sigma: p[2]} },
+		GoExpr: func(p []float64) string {
+			return fmt.Sprintf("distuv.StudentsT{Nu: %v, Mu: %v, Sigma: %v}", p[0], p[1], p[2])
+		},
+	},
+	{
+		Name:      "triangle",
+		Params:    []Param{{"a", required}, {"b", required}, {"c", required}},
+		Symmetric: func(p []float64) bool { return p[2] == (p[0]+p[1])/2 },
+		New:       func(p []float64) ziggurat.Distribution { return distuv.NewTriangle(p[0], p[1], p[2], nil) },
+		GoExpr:    func(p []float64) string { return fmt.Sprintf("distuv.NewTriangle(%v, %v, %v, nil)", p[0], p[1], p[2]) },
+	},
+	{
+		Name:      "weibull",
+		Params:    []Param{{"k", required}, {"lambda", 1}},
+		Symmetric: never,
+		New:       func(p []float64) ziggurat.Distribution { return distuv.Weibull{K: p[0], Lambda: p[1]} },
+		GoExpr:    func(p []float64) string { return fmt.Sprintf("distuv.Weibull{K: %v, Lambda: %v}", p[0], p[1]) },
+	},
+}
+
+// Lookup returns the entry for the named distribution.
+func Lookup(name string) (*Entry, error) {
+	for i := range entries {
+		if entries[i].Name == name {
+			return &entries[i], nil
+		}
+	}
+	return nil, fmt.Errorf("unknown distribution %q, expected one of: %s", name, strings.Join(Names(), ", "))
+}
+
+// Names returns the names of all distributions in the catalog.
+func Names() []string {
+	var names []string
+	for _, e := range entries {
+		names = append(names, e.Name)
+	}
+	return names
+}
+
+// Flags holds the values of the parameter flags of every distribution in the catalog.
+type Flags map[string]*float64
+
+// RegisterFlags defines a flag on fs for every distribution parameter in the catalog.
+func RegisterFlags(fs *flag.FlagSet) Flags {
+	f := Flags{}
+	for _, e := range entries {
+		for _, p := range e.Params {
+			if _, ok := f[p.Name]; !ok {
+				f[p.Name] = fs.Float64(p.Name, math.NaN(), "distribution parameter "+p.Name)
+			}
+		}
+	}
+	return f
+}
+
+// Params returns the parameters of e from the flags, filling in defaults.
+func (f Flags) Params(e *Entry) ([]float64, error) {
+	p := make([]float64, len(e.Params))
+	used := map[string]bool{}
+	for i, param := range e.Params {
+		used[param.Name] = true
+		p[i] = *f[param.Name]
+		if math.IsNaN(p[i]) {
+			p[i] = param.Default
+		}
+		if math.IsNaN(p[i]) {
+			return nil, fmt.Errorf("distribution %s requires parameter -%s", e.Name, param.Name)
+		}
+	}
+	var unused []string
+	for name, v := range f {
+		if !used[name] && !math.IsNaN(*v) {
+			unused = append(unused, "-"+name)
+		}
+	}
+	if len(unused) > 0 {
+		sort.Strings(unused)
+		return nil, fmt.Errorf("distribution %s does not take parameters %s", e.Name, strings.Join(unused, ", "))
+	}
+	return p, nil
+}
@@ -0,0 +1,67 @@
+// Package quad integrates functions by adaptive Gauss-Legendre quadrature, for the ziggurat and dists packages.
+package quad
+
+import "math"
+
+// The nodes and weights of 8 point Gauss-Legendre quadrature on [-1, 1], with the nodes symmetric about zero.
+var (
+	nodes   = [4]float64{0.1834346424956498, 0.5255324099163290, 0.7966664774136267, 0.9602898564975363}
+	weights = [4]float64{0.3626837833783620, 0.3137066458778873, 0.2223810344533745, 0.1012285362903763}
+)
+
+// GaussLegendre integrates f over [a, b] by 8 point Gauss-Legendre quadrature.
+// The ends are never evaluated, so f may be infinite there, as at a singular peak.
+func GaussLegendre(f func(x float64) float64, a, b float64) float64 {
+	c, h := a+(b-a)/2, (b-a)/2
+	var sum float64
+	for i, x := range nodes {
+		sum += weights[i] * (f(c-h*x) + f(c+h*x))
+	}
+	return sum * h
+}
+
+// The relative tolerance of Integrate.
+const Tol = 1e-13
+
+// Integrate integrates f over [a, b], starting from a few pieces, so a narrow peak can't hide between the nodes, and
+// halving each until its halves agree with it to within Tol of the total.
+func Integrate(f func(x float64) float64, a, b float64) float64 {
+	return IntegrateTol(f, a, b, Tol)
+}
+
+// IntegrateTol is like Integrate, to within tol of the total, for an integrand only known to about that accuracy, which
+// would otherwise be halved to the full depth chasing its rounding.
+func IntegrateTol(f func(x float64) float64, a, b, tol float64) float64 {
+	const pieces = 8
+	var estimates [pieces]float64
+	var total float64
+	for i := range estimates {
+		estimates[i] = GaussLegendre(f, a+(b-a)*float64(i)/pieces, a+(b-a)*float64(i+1)/pieces)
+		total += estimates[i]
+	}
+	tol *= math.Abs(total)
+	var sum float64
+	for i, whole := range estimates {
+		sum += adaptive(f, a+(b-a)*float64(i)/pieces, a+(b-a)*float64(i+1)/pieces, whole, tol, 0)
+	}
+	return sum
+}
+
+func adaptive(f func(x float64) float64, a, b, whole, tol float64, depth int) float64 {
+	mid := a + (b-a)/2
+	left, right := GaussLegendre(f, a, mid), GaussLegendre(f, mid, b)
+	// A NaN integrand stops at once, rather than splitting to the full depth.
+	if !(math.Abs(left+right-whole) > tol) || depth >= 40 {
+		return left + right
+	}
+	return adaptive(f, a, mid, left, tol, depth+1) + adaptive(f, mid, b, right, tol, depth+1)
+}
+
+// IntegrateAbove integrates f over [a, inf), with the change of variables x = a + width*u/(1-u).
+// Width is the scale over which f varies near a.
+func IntegrateAbove(f func(x float64) float64, a, width float64) float64 {
+	return Integrate(func(u float64) float64 {
+		v := 1 - u
+		return f(a+width*u/v) * width / (v * v)
+	}, 0, 1)
+}
@@ -0,0 +1,98 @@
+package ziggurat
+
+import "iter"
+
+// The number of samples drawn at a time by Samples and All.
+const sampleBatch = 256
+
+// A sequence of n samples, or unbounded if n is negative, drawn in batches by fill.
+func samples[T float32 | float64](fill func(dst []T), n int) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		var batch [sampleBatch]T
+		for n != 0 {
+			b := batch[:]
+			if n > 0 {
+				b = b[:min(n, sampleBatch)]
+				n -= len(b)
+			}
+			fill(b)
+			for _, x := range b {
+				if !yield(x) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func (z *ziggurat) Samples(n int) iter.Seq[float64] {
+	return samples(z.Fill, max(n, 0))
+}
+
+func (z *ziggurat) All() iter.Seq[float64] {
+	return samples(z.Fill, -1)
+}
+
+func (z symmetricZiggurat) Samples(n int) iter.Seq[float64] {
+	return samples(z.Fill, max(n, 0))
+}
+
+func (z symmetricZiggurat) All() iter.Seq[float64] {
+	return samples(z.Fill, -1)
+}
+
+func (z *flippedZiggurat) Samples(n int) iter.Seq[float64] {
+	return samples(z.Fill, max(n, 0))
+}
+
+func (z *flippedZiggurat) All() iter.Seq[float64] {
+	return samples(z.Fill, -1)
+}
+
+func (z *twoPartZiggurat) Samples(n int) iter.Seq[float64] {
+	return samples(z.Fill, max(n, 0))
+}
+
+func (z *twoPartZiggurat) All() iter.Seq[float64] {
+	return samples(z.Fill, -1)
+}
+
+func (z *mixtureZiggurat) Samples(n int) iter.Seq[float64] {
+	return samples(z.Fill, max(n, 0))
+}
+
+func (z *mixtureZiggurat) All() iter.Seq[float64] {
+	return samples(z.Fill, -1)
+}
+
+func (s *InstrumentedSampler) Samples(n int) iter.Seq[float64] {
+	return samples(s.Fill, max(n, 0))
+}
+
+func (s *InstrumentedSampler) All() iter.Seq[float64] {
+	return samples(s.Fill, -1)
+}
+
+func (z *ziggurat32) Samples(n int) iter.Seq[float32] {
+	return samples(z.Fill, max(n, 0))
+}
+
+func (z *ziggurat32) All() iter.Seq[float32] {
+	return samples(z.Fill, -1)
+}
+
+func (z symmetricZiggurat32) Samples(n int) iter.Seq[float32] {
+	return samples(z.Fill, max(n, 0))
+}
+
+func (z symmetricZiggurat32) All() iter.Seq[float32] {
+	return samples(z.Fill, -1)
+}
+
+func (z *twoPartZiggurat32) Samples(n int) iter.Seq[float32] {
+	return samples(z.Fill, max(n, 0))
+}
+
+func (z *twoPartZiggurat32) All() iter.Seq[float32] {
+	return samples(z.Fill, -1)
+}
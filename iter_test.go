@@ -0,0 +1,43 @@
+package ziggurat_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/vpxyz/xorshift/xoroshiro128plus"
+)
+
+// Samples and All must produce exactly the same samples as calls to Fill of the same lengths.
+func TestSamples(t *testing.T) {
+	for _, d := range FILL_DISTRIBUTIONS {
+		t.Run(d.Name, func(t *testing.T) {
+			// An odd length, so the last batch is partial. The rest is a multiple of the batch size, so All's batches match Fill's.
+			const N = FILL_SAMPLES/2 + 1
+			const M = 4096
+			Z := d.Fn(d.Dist, xoroshiro128plus.NewSource(1))
+			var want = make([]float64, N+M)
+			Z.Fill(want[:N])
+			Z.Fill(want[N:])
+			Z = d.Fn(d.Dist, xoroshiro128plus.NewSource(1))
+			got := slices.Collect(Z.Samples(N))
+			if len(got) != N {
+				t.Fatalf("Samples(%d) yielded %d samples", N, len(got))
+			}
+			// Samples must stop at exactly N, so the next samples continue the sequence.
+			for x := range Z.All() {
+				got = append(got, x)
+				if len(got) == len(want) {
+					break
+				}
+			}
+			for i := range got {
+				if got[i] != want[i] {
+					t.Fatalf("Iterated sample %d = %v, Fill sample = %v", i, got[i], want[i])
+				}
+			}
+			if n := len(slices.Collect(Z.Samples(-1))); n != 0 {
+				t.Errorf("Samples(-1) yielded %d samples", n)
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package ziggurat
+
+import (
+	"fmt"
+	"math"
+)
+
+func checkLocationScale(loc, scale float64) {
+	if math.IsNaN(loc) || math.IsInf(loc, 0) || math.IsNaN(scale) || math.IsInf(scale, 0) || scale <= 0 {
+		panic(fmt.Errorf("%w: location %v and scale %v", ErrInvalidOptions, loc, scale))
+	}
+}
+
+func (z *ziggurat) WithLocationScale(loc, scale float64) Sampler {
+	checkLocationScale(loc, scale)
+	v := *z
+	v.scale, v.shift = z.scale*scale, z.shift*scale+loc
+	return &v
+}
+
+func (z symmetricZiggurat) WithLocationScale(loc, scale float64) Sampler {
+	return symmetricZiggurat{r: z.r.WithLocationScale(loc, scale).(*ziggurat)}
+}
+
+func (z *flippedZiggurat) WithLocationScale(loc, scale float64) Sampler {
+	// loc + scale*(2*mode - X) = 2*(loc + scale*mode) - (loc + scale*X)
+	return &flippedZiggurat{Sampler: z.Sampler.WithLocationScale(loc, scale), mode: z.mode*scale + loc}
+}
+
+func (z *twoPartZiggurat) WithLocationScale(loc, scale float64) Sampler {
+	return &twoPartZiggurat{rightSideProb: z.rightSideProb, leftSide: z.leftSide.WithLocationScale(loc, scale), rightSide: z.rightSide.WithLocationScale(loc, scale), src: z.src}
+}
+
+func (z *mixtureZiggurat) WithLocationScale(loc, scale float64) Sampler {
+	parts := make([]Sampler, len(z.parts))
+	for i, part := range z.parts {
+		parts[i] = part.WithLocationScale(loc, scale)
+	}
+	return &mixtureZiggurat{alias: z.alias, parts: parts, src: z.src}
+}
+
+func (z *ziggurat32) WithLocationScale(loc, scale float64) Sampler32 {
+	checkLocationScale(loc, scale)
+	v := *z
+	v.scale, v.shift = z.scale*scale, z.shift*scale+loc
+	return &v
+}
+
+func (z symmetricZiggurat32) WithLocationScale(loc, scale float64) Sampler32 {
+	return symmetricZiggurat32{r: z.r.WithLocationScale(loc, scale).(*ziggurat32)}
+}
+
+func (z *twoPartZiggurat32) WithLocationScale(loc, scale float64) Sampler32 {
+	return &twoPartZiggurat32{rightSideProb: z.rightSideProb, leftSide: z.leftSide.WithLocationScale(loc, scale), rightSide: z.rightSide.WithLocationScale(loc, scale), src: z.src}
+}
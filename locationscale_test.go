@@ -0,0 +1,96 @@
+package ziggurat_test
+
+import (
+	"errors"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/argusdusty/ziggurat"
+	"github.com/vpxyz/xorshift/xoroshiro128plus"
+	"gonum.org/v1/gonum/stat/distuv"
+)
+
+const (
+	LOCATION_SCALE_ALPHA   = 0.001
+	LOCATION_SCALE_SAMPLES = 100_000
+)
+
+var LOCATION_SCALE_DISTRIBUTIONS = []struct {
+	Name       string
+	Base       ziggurat.Distribution
+	Fn         func(ziggurat.Distribution, rand.Source) ziggurat.Sampler
+	Loc, Scale float64
+	Dist       ziggurat.Distribution // The distribution of Loc + Scale*X, for X from Base.
+	Moment     func(m uint64) float64
+	MaxMoment  uint64
+}{
+	{Name: "Normal", Base: distuv.UnitNormal, Fn: ziggurat.ToZiggurat, Loc: 3, Scale: 2, Dist: distuv.Normal{Mu: 3, Sigma: 2}, Moment: scaledNormalMoment(3, 2), MaxMoment: 4},
+	{Name: "SymmetricNormal", Base: distuv.UnitNormal, Fn: ziggurat.ToSymmetricZiggurat, Loc: -1, Scale: 0.1, Dist: distuv.Normal{Mu: -1, Sigma: 0.1}, Moment: scaledNormalMoment(-1, 0.1), MaxMoment: 4},
+	{Name: "Gamma", Base: distuv.Gamma{Alpha: 2.5, Beta: 1}, Fn: ziggurat.ToZiggurat, Loc: 0, Scale: 0.25, Dist: distuv.Gamma{Alpha: 2.5, Beta: 4}, Moment: gammaMoment(2.5, 4), MaxMoment: 4},
+	{Name: "SmallGamma", Base: distuv.Gamma{Alpha: 0.5, Beta: 1}, Fn: ziggurat.ToZiggurat, Loc: 0, Scale: 10, Dist: distuv.Gamma{Alpha: 0.5, Beta: 0.1}, Moment: gammaMoment(0.5, 0.1), MaxMoment: 4},
+	{Name: "NegHalfNormal", Base: NegUnitHalfNormal{}, Fn: ziggurat.ToZiggurat, Loc: 5, Scale: 3, Dist: ziggurat.Truncate(distuv.Normal{Mu: 5, Sigma: 3}, math.Inf(-1), 5)},
+}
+
+func TestLocationScale(t *testing.T) {
+	for _, d := range LOCATION_SCALE_DISTRIBUTIONS {
+		t.Run(d.Name, func(t *testing.T) {
+			testDistributionAllRngs(t, d.Dist, d.Moment, d.MaxMoment, LOCATION_SCALE_SAMPLES, LOCATION_SCALE_ALPHA, func(dist ziggurat.Distribution, src rand.Source) ziggurat.Sampler {
+				// Compose two views, to check that the transforms combine.
+				return d.Fn(d.Base, src).WithLocationScale(1, 2).WithLocationScale(d.Loc-d.Scale/2, d.Scale/2)
+			})
+		})
+	}
+}
+
+// A view must produce exactly the transformed samples of the original.
+func TestLocationScaleView(t *testing.T) {
+	for _, d := range FILL_DISTRIBUTIONS {
+		t.Run(d.Name, func(t *testing.T) {
+			Z := d.Fn(d.Dist, xoroshiro128plus.NewSource(1))
+			V := d.Fn(d.Dist, xoroshiro128plus.NewSource(1)).WithLocationScale(4, 0.5)
+			for i := range FILL_SAMPLES {
+				if want, got := 4+0.5*Z.Rand(), V.Rand(); math.Abs(got-want) > 1e-12*math.Max(1, math.Abs(want)) {
+					t.Fatalf("View sample %d = %v, want %v", i, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestInvalidLocationScale(t *testing.T) {
+	Z := ziggurat.ToZiggurat(distuv.UnitNormal, nil)
+	for _, ls := range [][2]float64{{0, 0}, {0, -1}, {0, math.NaN()}, {math.Inf(1), 1}, {0, math.Inf(1)}} {
+		func() {
+			defer func() {
+				if err, _ := recover().(error); !errors.Is(err, ziggurat.ErrInvalidOptions) {
+					t.Errorf("WithLocationScale(%v, %v) panicked with %v, want %v", ls[0], ls[1], err, ziggurat.ErrInvalidOptions)
+				}
+			}()
+			Z.WithLocationScale(ls[0], ls[1])
+		}()
+	}
+	if _, err := ziggurat.TablesOf(Z.WithLocationScale(1, 2)); err == nil {
+		t.Errorf("TablesOf a location-scale view returned no error")
+	}
+}
+
+func BenchmarkLocationScale(b *testing.B) {
+	Z := ziggurat.ToZiggurat(distuv.UnitNormal, xoroshiro128plus.NewSource(rand.Int64()))
+	b.Run("construction=Ziggurat", func(b *testing.B) {
+		for b.Loop() {
+			ziggurat.ToZiggurat(distuv.Normal{Mu: 3, Sigma: 2}, nil)
+		}
+	})
+	b.Run("construction=WithLocationScale", func(b *testing.B) {
+		for b.Loop() {
+			Z.WithLocationScale(3, 2)
+		}
+	})
+	b.Run("sample", func(b *testing.B) {
+		V := Z.WithLocationScale(3, 2)
+		for b.Loop() {
+			V.Rand()
+		}
+	})
+}
@@ -0,0 +1,153 @@
+package ziggurat
+
+import (
+	"fmt"
+	"math"
+	"math/bits"
+	"math/rand/v2"
+)
+
+// A sampler which picks one of several parts by their probabilities, generalizing twoPartZiggurat.
+type mixtureZiggurat struct {
+	alias aliasTable
+	parts []Sampler
+	src   rand.Source
+	batch *mixtureBatch // The buffers for Fill, allocated on its first call.
+}
+
+// The samples of each part for a batch of Fill, grouped by part, and the position in samples of the next of each part.
+type mixtureBatch struct {
+	samples [sampleBatch]float64
+	next    []int
+}
+
+// Walker's alias table, for picking an index with given probabilities in constant time.
+type aliasTable struct {
+	probs   []float64 // The probability of keeping each index, rather than taking its alias.
+	aliases []int
+}
+
+// Build an alias table with Vose's method. The weights must be non-negative with a positive sum.
+func newAliasTable(weights []float64) aliasTable {
+	n := len(weights)
+	total := 0.0
+	for _, w := range weights {
+		total += w
+	}
+	a := aliasTable{probs: make([]float64, n), aliases: make([]int, n)}
+	scaled := make([]float64, n)
+	var small, large []int
+	for i, w := range weights {
+		scaled[i] = w * float64(n) / total
+		a.aliases[i] = i
+		if scaled[i] < 1 {
+			small = append(small, i)
+		} else {
+			large = append(large, i)
+		}
+	}
+	for len(small) > 0 && len(large) > 0 {
+		s, l := small[len(small)-1], large[len(large)-1]
+		small = small[:len(small)-1]
+		a.probs[s], a.aliases[s] = scaled[s], l
+		scaled[l] -= 1 - scaled[s]
+		if scaled[l] < 1 {
+			small, large = append(small, l), large[:len(large)-1]
+		}
+	}
+	// Whatever remains is within rounding error of 1.
+	for _, i := range append(small, large...) {
+		a.probs[i] = 1.0
+	}
+	return a
+}
+
+// Pick an index from the random bits r, using the high bits of r*n for the index and the low bits to choose between it and its alias.
+func (a aliasTable) pick(r uint64) int {
+	i, frac := bits.Mul64(r, uint64(len(a.probs)))
+	if float64(frac>>11)/(1<<53) < a.probs[i] {
+		return int(i)
+	}
+	return a.aliases[i]
+}
+
+// ToMixtureZiggurat constructs a Sampler for the mixture of components, each chosen with probability proportional to its weight.
+// It panics if the ziggurat cannot be constructed.
+func ToMixtureZiggurat(weights []float64, components []Distribution, src rand.Source) Sampler {
+	return ToMixtureZigguratWithOptions(weights, components, src, Options{})
+}
+
+// ToMixtureZigguratWithOptions is like ToMixtureZiggurat, but configured by opts. It panics if the ziggurat cannot be constructed.
+func ToMixtureZigguratWithOptions(weights []float64, components []Distribution, src rand.Source, opts Options) Sampler {
+	return must(NewMixtureZiggurat(weights, components, src, opts))
+}
+
+// NewMixtureZiggurat constructs a Sampler for the mixture of components, each chosen with probability proportional to its weight,
+// returning an error if a component is invalid. Each component with a positive weight is sampled by its own ziggurat.
+func NewMixtureZiggurat(weights []float64, components []Distribution, src rand.Source, opts Options) (_ Sampler, err error) {
+	defer recoverDistribution(&err)
+	if src == nil {
+		src = globalRand{}
+	}
+	if len(weights) != len(components) {
+		return nil, fmt.Errorf("%w: %d weights for %d components", ErrInvalidWeights, len(weights), len(components))
+	}
+	var parts []Sampler
+	var partWeights []float64
+	for i, w := range weights {
+		if math.IsNaN(w) || math.IsInf(w, 0) || w < 0 {
+			return nil, fmt.Errorf("%w: component %d has weight %v", ErrInvalidWeights, i, w)
+		}
+		if w == 0 {
+			continue
+		}
+		part, err := NewZiggurat(components[i], src, opts)
+		if err != nil {
+			return nil, fmt.Errorf("component %d: %w", i, err)
+		}
+		parts = append(parts, part)
+		partWeights = append(partWeights, w)
+	}
+	if len(parts) == 0 {
+		return nil, fmt.Errorf("%w: no component has a positive weight", ErrInvalidWeights)
+	}
+	return newMixtureZiggurat(partWeights, parts, src), nil
+}
+
+func newMixtureZiggurat(weights []float64, parts []Sampler, src rand.Source) *mixtureZiggurat {
+	return &mixtureZiggurat{alias: newAliasTable(weights), parts: parts, src: src}
+}
+
+func (z *mixtureZiggurat) Rand() float64 {
+	return z.parts[z.alias.pick(z.src.Uint64())].Rand()
+}
+
+// Fill dst in batches, choosing the part of each sample first, then filling the samples of each part at once.
+func (z *mixtureZiggurat) Fill(dst []float64) {
+	if z.batch == nil {
+		z.batch = &mixtureBatch{next: make([]int, len(z.parts))}
+	}
+	samples, next := z.batch.samples[:], z.batch.next
+	var parts [sampleBatch]int
+	for len(dst) > 0 {
+		n := min(len(dst), sampleBatch)
+		clear(next)
+		for i := range n {
+			parts[i] = z.alias.pick(z.src.Uint64())
+			next[parts[i]]++
+		}
+		start := 0
+		for j, count := range next {
+			next[j] = start
+			if count > 0 {
+				z.parts[j].Fill(samples[start : start+count])
+				start += count
+			}
+		}
+		for i, j := range parts[:n] {
+			dst[i] = samples[next[j]]
+			next[j]++
+		}
+		dst = dst[n:]
+	}
+}
@@ -0,0 +1,143 @@
+package ziggurat_test
+
+import (
+	"errors"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/argusdusty/ziggurat"
+	"github.com/vpxyz/xorshift/xoroshiro128plus"
+	"gonum.org/v1/gonum/stat/distuv"
+)
+
+const (
+	MIXTURE_ALPHA   = 0.001
+	MIXTURE_SAMPLES = 100_000
+)
+
+// A weighted mixture of distributions, with the moments of each component.
+type Mixture struct {
+	Weights    []float64
+	Components []ziggurat.Distribution
+	Moments    []func(m uint64) float64
+}
+
+func (M Mixture) total() float64 {
+	var total float64
+	for _, w := range M.Weights {
+		total += w
+	}
+	return total
+}
+
+func (M Mixture) Mode() float64 {
+	return math.NaN() // Unused
+}
+
+func (M Mixture) Prob(x float64) float64 {
+	var p float64
+	for i, w := range M.Weights {
+		p += w * M.Components[i].Prob(x)
+	}
+	return p / M.total()
+}
+
+func (M Mixture) Survival(x float64) float64 {
+	var s float64
+	for i, w := range M.Weights {
+		s += w * M.Components[i].Survival(x)
+	}
+	return s / M.total()
+}
+
+func (M Mixture) Quantile(p float64) float64 {
+	return math.NaN() // Unused
+}
+
+func (M Mixture) Moment(m uint64) float64 {
+	var EXM float64
+	for i, w := range M.Weights {
+		EXM += w * M.Moments[i](m)
+	}
+	return EXM / M.total()
+}
+
+func scaledNormalMoment(mu, sigma float64) func(m uint64) float64 {
+	return NormalMixture{Weights: []float64{1}, Mus: []float64{mu}, Sigmas: []float64{sigma}}.Moment
+}
+
+func gammaMoment(alpha, beta float64) func(m uint64) float64 {
+	return func(m uint64) float64 {
+		lg1, _ := math.Lgamma(alpha + float64(m))
+		lg2, _ := math.Lgamma(alpha)
+		return math.Exp(lg1-lg2) / math.Pow(beta, float64(m))
+	}
+}
+
+var MIXTURE_DISTRIBUTIONS = []struct {
+	Name string
+	Dist Mixture
+}{
+	{Name: "Normal", Dist: Mixture{
+		Weights:    []float64{1, 2, 0.5},
+		Components: []ziggurat.Distribution{distuv.Normal{Mu: -2, Sigma: 1}, distuv.Normal{Mu: 0, Sigma: 0.5}, distuv.Normal{Mu: 5, Sigma: 2}},
+		Moments:    []func(m uint64) float64{scaledNormalMoment(-2, 1), scaledNormalMoment(0, 0.5), scaledNormalMoment(5, 2)},
+	}},
+	{Name: "Gamma", Dist: Mixture{
+		Weights:    []float64{0.2, 0.8},
+		Components: []ziggurat.Distribution{distuv.Gamma{Alpha: 0.5, Beta: 1}, distuv.Gamma{Alpha: 4, Beta: 2}},
+		Moments:    []func(m uint64) float64{gammaMoment(0.5, 1), gammaMoment(4, 2)},
+	}},
+	{Name: "ZeroWeight", Dist: Mixture{
+		Weights:    []float64{0, 1, 0},
+		Components: []ziggurat.Distribution{distuv.Normal{Mu: -2, Sigma: 1}, distuv.Normal{Mu: 1, Sigma: 2}, distuv.Normal{Mu: 5, Sigma: 2}},
+		Moments:    []func(m uint64) float64{scaledNormalMoment(-2, 1), scaledNormalMoment(1, 2), scaledNormalMoment(5, 2)},
+	}},
+}
+
+func TestMixture(t *testing.T) {
+	for _, d := range MIXTURE_DISTRIBUTIONS {
+		t.Run(d.Name, func(t *testing.T) {
+			testDistributionAllRngs(t, d.Dist, d.Dist.Moment, 4, MIXTURE_SAMPLES, MIXTURE_ALPHA, func(dist ziggurat.Distribution, src rand.Source) ziggurat.Sampler {
+				return ziggurat.ToMixtureZiggurat(d.Dist.Weights, d.Dist.Components, src)
+			})
+		})
+	}
+}
+
+// Fill must sample the mixture at each position, so it must interleave the samples of the components.
+func TestMixtureFill(t *testing.T) {
+	for _, d := range MIXTURE_DISTRIBUTIONS {
+		t.Run(d.Name, func(t *testing.T) {
+			Z := ziggurat.ToMixtureZiggurat(d.Dist.Weights, d.Dist.Components, xoroshiro128plus.NewSource(1))
+			var samples = make([]float64, MIXTURE_SAMPLES)
+			Z.Fill(samples)
+			testMoments(t, samples, d.Dist.Moment, 4, MIXTURE_ALPHA)
+			testAndersonDarling(t, samples, d.Dist, MIXTURE_ALPHA)
+			for _, i := range []int{0, FILL_POSITION_LEN - 1} {
+				testAndersonDarling(t, positionSamples(Z.Fill, FILL_POSITION_LEN, i), d.Dist, FILL_POSITION_ALPHA)
+			}
+		})
+	}
+}
+
+func TestInvalidWeights(t *testing.T) {
+	components := []ziggurat.Distribution{distuv.UnitNormal, distuv.UnitNormal}
+	for _, weights := range [][]float64{{1}, {0, 0}, {1, -1}, {1, math.NaN()}, {1, math.Inf(1)}} {
+		if _, err := ziggurat.NewMixtureZiggurat(weights, components, nil, ziggurat.Options{}); !errors.Is(err, ziggurat.ErrInvalidWeights) {
+			t.Errorf("NewMixtureZiggurat with weights %v returned error %v, want %v", weights, err, ziggurat.ErrInvalidWeights)
+		}
+	}
+}
+
+func BenchmarkMixture(b *testing.B) {
+	for _, d := range MIXTURE_DISTRIBUTIONS {
+		b.Run(d.Name, func(b *testing.B) {
+			Z := ziggurat.ToMixtureZiggurat(d.Dist.Weights, d.Dist.Components, xoroshiro128plus.NewSource(rand.Int64()))
+			for b.Loop() {
+				Z.Rand()
+			}
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package ziggurat
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"slices"
+)
+
+// ToMultimodalZiggurat constructs a Sampler for a distribution whose density is monotone between each of the given local extrema.
+// It panics if the ziggurat cannot be constructed.
+func ToMultimodalZiggurat(distribution Distribution, extrema []float64, src rand.Source) Sampler {
+	return ToMultimodalZigguratWithOptions(distribution, extrema, src, Options{})
+}
+
+// ToMultimodalZigguratWithOptions is like ToMultimodalZiggurat, but configured by opts. It panics if the ziggurat cannot be constructed.
+func ToMultimodalZigguratWithOptions(distribution Distribution, extrema []float64, src rand.Source, opts Options) Sampler {
+	return must(NewMultimodalZiggurat(distribution, extrema, src, opts))
+}
+
+// NewMultimodalZiggurat constructs a Sampler for a distribution whose density is monotone between each of the given local extrema,
+// returning an error if the distribution is invalid. The extrema, both maxima and minima, must be finite and strictly increasing,
+// and there must be at least one. A unimodal distribution with a reliable Mode is sampled faster by NewZiggurat.
+// Where the density does not fall to zero at a bound of its support, the bound counts as an extremum.
+// Each monotone segment is sampled by its own ziggurat, chosen by its probability. The Mode of the distribution is not used.
+func NewMultimodalZiggurat(distribution Distribution, extrema []float64, src rand.Source, opts Options) (_ Sampler, err error) {
+	defer recoverDistribution(&err)
+	if src == nil {
+		src = globalRand{}
+	}
+	if len(extrema) == 0 {
+		return nil, fmt.Errorf("%w: no extrema", ErrInvalidExtrema)
+	}
+	for i, x := range extrema {
+		if math.IsNaN(x) || math.IsInf(x, 0) || (i > 0 && x <= extrema[i-1]) {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidExtrema, extrema)
+		}
+	}
+	bounds := append(append([]float64{math.Inf(-1)}, extrema...), math.Inf(1))
+	var parts []Sampler
+	var masses []float64
+	for i := range len(bounds) - 1 {
+		lo, hi := bounds[i], bounds[i+1]
+		mode := lo
+		// The density may only be infinite at an extremum, so compare the density just inside the segment.
+		if math.IsInf(lo, -1) || (!math.IsInf(hi, 1) && distribution.Prob(math.Nextafter(hi, lo)) > distribution.Prob(math.Nextafter(lo, hi))) {
+			mode = hi
+		}
+		segment := newTruncated(distribution, lo, hi, mode)
+		mass := math.Exp(segment.logMass)
+		if math.IsNaN(mass) || mass < 0 {
+			return nil, fmt.Errorf("%w: probability %v between %v and %v", ErrNonMonotoneSurvival, mass, segment.lo, segment.hi)
+		}
+		if mass == 0 {
+			continue
+		}
+		part, err := NewZiggurat(segment, src, opts)
+		if err != nil {
+			return nil, fmt.Errorf("segment between %v and %v: %w", segment.lo, segment.hi, err)
+		}
+		parts = append(parts, part)
+		masses = append(masses, mass)
+	}
+	if len(parts) == 0 {
+		return nil, fmt.Errorf("%w: distribution has no probability", ErrInvalidDensity)
+	}
+	return newMixtureZiggurat(masses, parts, src), nil
+}
+
+// FindExtrema returns the local extrema of the density of distribution between lo and hi, in increasing order,
+// for use with NewMultimodalZiggurat. The density is evaluated at evenly spaced points, and each change of direction
+// is refined by a golden-section search, so extrema closer together than the spacing may be missed.
+func FindExtrema(distribution Distribution, lo, hi float64, points int) []float64 {
+	if points < 3 || !(lo < hi) {
+		return nil
+	}
+	x := make([]float64, points)
+	p := make([]float64, points)
+	for i := range x {
+		x[i] = lo + (hi-lo)*float64(i)/float64(points-1)
+		p[i] = distribution.Prob(x[i])
+	}
+	var extrema []float64
+	direction := 0 // The sign of the slope before x[i], ignoring flat stretches.
+	last := 0      // The end of the last change in the density, before any flat stretch since.
+	for i := 1; i < points; i++ {
+		d := 0
+		if p[i] > p[i-1] {
+			d = 1
+		} else if p[i] < p[i-1] {
+			d = -1
+		}
+		if d == 0 {
+			continue
+		}
+		if direction != 0 && d != direction {
+			// After a flat stretch, the extremum may lie anywhere since the last change.
+			extrema = append(extrema, goldenSection(distribution.Prob, x[last-1], x[i], direction > 0))
+		}
+		direction, last = d, i
+	}
+	return slices.Compact(extrema)
+}
+
+// Find the maximum (or minimum) of fn between a and b, assuming it is unimodal there.
+func goldenSection(fn func(float64) float64, a, b float64, maximum bool) float64 {
+	better := func(x, y float64) bool {
+		if maximum {
+			return fn(x) > fn(y)
+		}
+		return fn(x) < fn(y)
+	}
+	const invPhi = 0.6180339887498949
+	for range 200 {
+		c := b - (b-a)*invPhi
+		d := a + (b-a)*invPhi
+		if c <= a || d >= b || c >= d {
+			break
+		}
+		if better(c, d) {
+			b = d
+		} else {
+			a = c
+		}
+	}
+	return (a + b) / 2
+}
@@ -0,0 +1,227 @@
+package ziggurat_test
+
+import (
+	"errors"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/argusdusty/ziggurat"
+	"github.com/vpxyz/xorshift/xoroshiro128plus"
+	"gonum.org/v1/gonum/stat/distuv"
+)
+
+const (
+	MULTIMODAL_ALPHA   = 0.001
+	MULTIMODAL_SAMPLES = 100_000
+)
+
+// A mixture of normal distributions.
+type NormalMixture struct {
+	Weights []float64
+	Mus     []float64
+	Sigmas  []float64
+}
+
+// NewMultimodalZiggurat must not use the Mode, which a multimodal distribution lacks.
+func (M NormalMixture) Mode() float64 {
+	panic("NormalMixture has no mode")
+}
+
+func (M NormalMixture) Prob(x float64) float64 {
+	var p float64
+	for i, w := range M.Weights {
+		p += w * distuv.Normal{Mu: M.Mus[i], Sigma: M.Sigmas[i]}.Prob(x)
+	}
+	return p
+}
+
+func (M NormalMixture) Survival(x float64) float64 {
+	var s float64
+	for i, w := range M.Weights {
+		s += w * distuv.Normal{Mu: M.Mus[i], Sigma: M.Sigmas[i]}.Survival(x)
+	}
+	return s
+}
+
+func (M NormalMixture) Quantile(p float64) float64 {
+	if p <= 0 {
+		return math.Inf(-1)
+	}
+	if p >= 1 {
+		return math.Inf(1)
+	}
+	lo, hi := -1.0, 1.0
+	for 1-M.Survival(lo) > p {
+		lo *= 2
+	}
+	for 1-M.Survival(hi) < p {
+		hi *= 2
+	}
+	for range 100 {
+		mid := (lo + hi) / 2
+		if 1-M.Survival(mid) < p {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return (lo + hi) / 2
+}
+
+func (M NormalMixture) Moment(m uint64) float64 {
+	var EXM float64
+	for i, w := range M.Weights {
+		// E[(mu + sigma*Z)^m] by the binomial expansion, with E[Z^k] = (k-1)!! for even k.
+		var e, binomial, zMoment float64 = 0, 1, 1
+		for k := uint64(0); k <= m; k++ {
+			if k > 0 {
+				binomial = binomial * float64(m-k+1) / float64(k)
+			}
+			if k%2 == 0 {
+				if k > 0 {
+					zMoment *= float64(k - 1)
+				}
+				e += binomial * math.Pow(M.Mus[i], float64(m-k)) * math.Pow(M.Sigmas[i], float64(k)) * zMoment
+			}
+		}
+		EXM += w * e
+	}
+	return EXM
+}
+
+// A Laplace distribution with a different scale on each side of zero.
+type AsymmetricLaplace struct {
+	Left, Right float64 // The scale on each side.
+}
+
+func (A AsymmetricLaplace) Mode() float64 {
+	return 0.0
+}
+
+func (A AsymmetricLaplace) Prob(x float64) float64 {
+	if x < 0 {
+		return math.Exp(x/A.Left) / (A.Left + A.Right)
+	}
+	return math.Exp(-x/A.Right) / (A.Left + A.Right)
+}
+
+func (A AsymmetricLaplace) Survival(x float64) float64 {
+	if x < 0 {
+		return 1 - A.Left*math.Exp(x/A.Left)/(A.Left+A.Right)
+	}
+	return A.Right * math.Exp(-x/A.Right) / (A.Left + A.Right)
+}
+
+func (A AsymmetricLaplace) Quantile(p float64) float64 {
+	if p < A.Left/(A.Left+A.Right) {
+		return A.Left * math.Log(p*(A.Left+A.Right)/A.Left)
+	}
+	return -A.Right * math.Log((1-p)*(A.Left+A.Right)/A.Right)
+}
+
+func (A AsymmetricLaplace) Moment(m uint64) float64 {
+	// E[X^m] = (Right^(m+1) + (-1)^m Left^(m+1)) m! / (Left + Right)
+	return (math.Pow(A.Right, float64(m+1)) + math.Pow(-A.Left, float64(m))*A.Left) * math.Gamma(float64(m+1)) / (A.Left + A.Right)
+}
+
+var MULTIMODAL_DISTRIBUTIONS = []struct {
+	Name    string
+	Dist    ziggurat.Distribution
+	Moment  func(m uint64) float64
+	Extrema []float64
+}{
+	{Name: "Bimodal", Dist: NormalMixture{Weights: []float64{0.5, 0.5}, Mus: []float64{-3, 3}, Sigmas: []float64{1, 1}}, Extrema: []float64{-3, 0, 3}},
+	{Name: "AsymmetricBimodal", Dist: NormalMixture{Weights: []float64{0.3, 0.7}, Mus: []float64{-2, 4}, Sigmas: []float64{0.5, 2}}},
+	{Name: "Trimodal", Dist: NormalMixture{Weights: []float64{0.2, 0.5, 0.3}, Mus: []float64{-5, 0, 5}, Sigmas: []float64{1, 1, 1}}},
+	{Name: "AsymmetricLaplace", Dist: AsymmetricLaplace{Left: 0.5, Right: 3}, Moment: AsymmetricLaplace{Left: 0.5, Right: 3}.Moment, Extrema: []float64{0}},
+}
+
+func TestMultimodal(t *testing.T) {
+	for _, d := range MULTIMODAL_DISTRIBUTIONS {
+		t.Run(d.Name, func(t *testing.T) {
+			extrema := d.Extrema
+			if extrema == nil {
+				extrema = ziggurat.FindExtrema(d.Dist, -20, 20, 1000)
+			}
+			moment := d.Moment
+			if moment == nil {
+				moment = d.Dist.(NormalMixture).Moment
+			}
+			testDistributionAllRngs(t, d.Dist, moment, 4, MULTIMODAL_SAMPLES, MULTIMODAL_ALPHA, func(dist ziggurat.Distribution, src rand.Source) ziggurat.Sampler {
+				return ziggurat.ToMultimodalZiggurat(dist, extrema, src)
+			})
+		})
+	}
+}
+
+func TestFindExtrema(t *testing.T) {
+	got := ziggurat.FindExtrema(NormalMixture{Weights: []float64{0.5, 0.5}, Mus: []float64{-3, 3}, Sigmas: []float64{1, 1}}, -10, 10, 100)
+	want := []float64{-3, 0, 3}
+	if len(got) != len(want) {
+		t.Fatalf("FindExtrema = %v, want %v", got, want)
+	}
+	for i := range got {
+		if math.Abs(got[i]-want[i]) > 1e-6 {
+			t.Errorf("FindExtrema = %v, want %v", got, want)
+		}
+	}
+}
+
+// A density rising to a plateau on [0, 2], with a peak at 0.5 between the points of a coarse grid, before falling.
+type PlateauPeak struct{}
+
+func (PlateauPeak) Mode() float64 {
+	return 0.5
+}
+
+func (PlateauPeak) Prob(x float64) float64 {
+	switch {
+	case x < -1 || x > 3:
+		return 0
+	case x < 0:
+		return x + 1
+	case x > 2:
+		return 3 - x
+	}
+	return 1 + max(0, 1-2*math.Abs(x-0.5))
+}
+
+func (PlateauPeak) Survival(x float64) float64 {
+	return math.NaN() // Unused
+}
+
+func (PlateauPeak) Quantile(p float64) float64 {
+	return math.NaN() // Unused
+}
+
+// The grid sees the density flat from 0 to 2, so the peak must be found before the point where it falls.
+func TestFindExtremaPlateau(t *testing.T) {
+	got := ziggurat.FindExtrema(PlateauPeak{}, -1, 3, 5)
+	if len(got) != 1 || math.Abs(got[0]-0.5) > 1e-6 {
+		t.Errorf("FindExtrema = %v, want [0.5]", got)
+	}
+}
+
+func TestInvalidExtrema(t *testing.T) {
+	for _, extrema := range [][]float64{nil, {}, {1, 0}, {0, 0}, {math.NaN()}, {math.Inf(1)}} {
+		if _, err := ziggurat.NewMultimodalZiggurat(BimodalNormal{}, extrema, nil, ziggurat.Options{}); !errors.Is(err, ziggurat.ErrInvalidExtrema) {
+			t.Errorf("NewMultimodalZiggurat with extrema %v returned error %v, want %v", extrema, err, ziggurat.ErrInvalidExtrema)
+		}
+	}
+	// Missing the minimum between the two modes.
+	if _, err := ziggurat.NewMultimodalZiggurat(MULTIMODAL_DISTRIBUTIONS[0].Dist, []float64{-3, 3}, nil, ziggurat.Options{}); !errors.Is(err, ziggurat.ErrNotUnimodal) {
+		t.Errorf("NewMultimodalZiggurat with missing extrema returned error %v, want %v", err, ziggurat.ErrNotUnimodal)
+	}
+}
+
+func BenchmarkMultimodal(b *testing.B) {
+	for _, d := range MULTIMODAL_DISTRIBUTIONS {
+		b.Run(d.Name, func(b *testing.B) {
+			Z := ziggurat.ToMultimodalZiggurat(d.Dist, ziggurat.FindExtrema(d.Dist, -20, 20, 1000), xoroshiro128plus.NewSource(rand.Int64()))
+			for b.Loop() {
+				Z.Rand()
+			}
+		})
+	}
+}
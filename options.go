@@ -0,0 +1,57 @@
+package ziggurat
+
+import (
+	"fmt"
+	"math/bits"
+)
+
+// Options configures the construction of a Sampler. The zero value uses the defaults.
+type Options struct {
+	// The number of strips in the ziggurat. Must be a power of two between 2 and ZIGGURAT_N, defaults to ZIGGURAT_N.
+	// For a Sampler32, the maximum and default is ZIGGURAT32_N.
+	// Fewer strips use less memory, at the cost of more frequent rejections.
+	Strips int
+	// How to sample from an infinite tail, defaults to TailQuantile. The envelopes of the other methods need only the density,
+	// and are fitted numerically assuming the log density is smooth in the tail.
+	Tail Tail
+}
+
+func (o Options) strips(maxStrips int) (int, error) {
+	if o.Strips == 0 {
+		return maxStrips, nil
+	}
+	if o.Strips < 2 || o.Strips > maxStrips || bits.OnesCount(uint(o.Strips)) != 1 {
+		return 0, fmt.Errorf("%w: Strips must be a power of two between 2 and %d, got %d", ErrInvalidOptions, maxStrips, o.Strips)
+	}
+	return o.Strips, nil
+}
+
+func (o Options) tail() (Tail, error) {
+	if o.Tail < 0 || int(o.Tail) >= len(tailNames) {
+		return 0, fmt.Errorf("%w: unknown %v", ErrInvalidOptions, o.Tail)
+	}
+	return o.Tail, nil
+}
+
+// Tail selects how a Sampler samples from an infinite tail, beyond the base strip of the ziggurat.
+type Tail int
+
+const (
+	// TailQuantile inverts the Quantile function of the distribution, which is exact, but may be slow or inaccurate far into the tail.
+	TailQuantile Tail = iota
+	// TailAuto uses whichever of TailExponential and TailPareto rejects least often, or TailQuantile if neither bounds the tail.
+	TailAuto
+	// TailExponential samples by rejection from an exponential envelope, for light tails such as the normal and gamma distributions.
+	TailExponential
+	// TailPareto samples by rejection from a Pareto envelope, for power-law tails such as Student's t distribution.
+	TailPareto
+)
+
+var tailNames = [...]string{TailQuantile: "quantile", TailAuto: "auto", TailExponential: "exponential", TailPareto: "pareto"}
+
+func (t Tail) String() string {
+	if t < 0 || int(t) >= len(tailNames) {
+		return fmt.Sprintf("Tail(%d)", int(t))
+	}
+	return tailNames[t]
+}
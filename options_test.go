@@ -0,0 +1,58 @@
+package ziggurat_test
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/argusdusty/ziggurat"
+	"gonum.org/v1/gonum/stat/distuv"
+)
+
+var STRIPS = []int{2, 64, 128, 256, 1024}
+
+func TestStrips(t *testing.T) {
+	for _, strips := range STRIPS {
+		opts := ziggurat.Options{Strips: strips}
+		t.Run(fmt.Sprintf("strips=%d", strips), func(t *testing.T) {
+			t.Run("Normal", func(t *testing.T) {
+				testDistributionAllRngs(t, distuv.UnitNormal, normalMoment, 4, NORMAL_SAMPLES, NORMAL_ALPHA, func(dist ziggurat.Distribution, src rand.Source) ziggurat.Sampler {
+					return ziggurat.ToZigguratWithOptions(dist, src, opts)
+				})
+			})
+			t.Run("SymmetricNormal", func(t *testing.T) {
+				testDistributionAllRngs(t, distuv.UnitNormal, normalMoment, 4, NORMAL_SAMPLES, NORMAL_ALPHA, func(dist ziggurat.Distribution, src rand.Source) ziggurat.Sampler {
+					return ziggurat.ToSymmetricZigguratWithOptions(dist, src, opts)
+				})
+			})
+			t.Run("HalfNormal", func(t *testing.T) {
+				testDistributionAllRngs(t, UnitHalfNormal{}, halfNormalMoment, 4, HALF_NORMAL_SAMPLES, HALF_NORMAL_ALPHA, func(dist ziggurat.Distribution, src rand.Source) ziggurat.Sampler {
+					return ziggurat.ToZigguratWithOptions(dist, src, opts)
+				})
+			})
+		})
+	}
+}
+
+func TestInvalidStrips(t *testing.T) {
+	for _, strips := range []int{-1, 1, 3, 100, 2 * ziggurat.ZIGGURAT_N} {
+		t.Run(fmt.Sprintf("strips=%d", strips), func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("ToZigguratWithOptions did not panic with %d strips", strips)
+				}
+			}()
+			ziggurat.ToZigguratWithOptions(distuv.UnitNormal, nil, ziggurat.Options{Strips: strips})
+		})
+	}
+}
+
+func BenchmarkStrips(b *testing.B) {
+	for _, strips := range STRIPS {
+		b.Run(fmt.Sprintf("strips=%d", strips), func(b *testing.B) {
+			benchmarkDistributionAllRngs(b, func(src rand.Source) distuv.Rander {
+				return ziggurat.ToSymmetricZigguratWithOptions(distuv.UnitNormal, src, ziggurat.Options{Strips: strips})
+			})
+		})
+	}
+}
@@ -0,0 +1,320 @@
+package ziggurat
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+
+	"github.com/argusdusty/ziggurat/internal/quad"
+)
+
+// PDFOptions configures FromPDF. The zero value locates the mode numerically, searching outwards from zero.
+type PDFOptions struct {
+	// The mode of the density, used instead of a numerical search if ModeKnown is set.
+	Mode      float64
+	ModeKnown bool
+	// A point near the mode, from which the numerical search starts. It is clamped to the support.
+	Guess float64
+}
+
+// A Distribution defined by a unimodal density alone, with the survival function tabulated by numerical integration.
+type pdfDistribution struct {
+	pdf    func(float64) float64
+	lo, hi float64 // The support.
+	mode   float64
+	xs     []float64 // Cell boundaries, strictly increasing. The density is negligible outside [xs[0], xs[len(xs)-1]].
+	below  []float64 // The unnormalized mass below each boundary.
+	above  []float64 // The unnormalized mass above each boundary.
+	total  float64
+	// For FromLogPDF, the log density, and the log of its integral over the support.
+	logPDF   func(float64) float64
+	logScale float64
+}
+
+// The cells either side of the mode are spaced geometrically in their distance from it, from pdfMinCell up to where the
+// density vanishes or overflows, so that both singular peaks and heavy tails are integrated accurately.
+const (
+	pdfCellsPerE = 8
+	pdfMinCell   = -80 * pdfCellsPerE // The log of the distance to the mode of the first boundary, in cells.
+	pdfMaxCell   = 710 * pdfCellsPerE // Beyond the largest float64.
+)
+
+// FromPDF returns a Distribution for the unimodal density pdf on support, so it can be passed to ToZiggurat.
+// The density need not be normalized. The survival function is computed by numerically integrating pdf,
+// and inverted numerically for the quantile function. Either end of the support may be infinite.
+func FromPDF(pdf func(float64) float64, support [2]float64, opts PDFOptions) (Distribution, error) {
+	mode, err := pdfMode(pdf, support, opts)
+	if err != nil {
+		return nil, err
+	}
+	return newPDFDistribution(pdf, support, mode)
+}
+
+// FromLogPDF is like FromPDF, but for the log of an unnormalized density, such as a Bayesian posterior.
+// The density is scaled by its value at the mode before integrating, so it may be far too small or large to represent as a float64.
+// The result is a LogDistribution.
+func FromLogPDF(logPDF func(float64) float64, support [2]float64, opts PDFOptions) (LogDistribution, error) {
+	mode, err := pdfMode(logPDF, support, opts)
+	if err != nil {
+		return nil, err
+	}
+	shift := logPDF(mode)
+	if math.IsInf(shift, 1) {
+		// The density is singular at the mode, so scale it by its value nearby instead.
+		shift = math.Inf(-1)
+		for _, x := range []float64{mode - 1, mode + 1, mode + (support[0]-mode)/2, mode + (support[1]-mode)/2} {
+			if l := logPDF(x); x > support[0] && x < support[1] && l > shift && !math.IsInf(l, 1) {
+				shift = l
+			}
+		}
+	}
+	if math.IsNaN(shift) || math.IsInf(shift, 0) {
+		return nil, fmt.Errorf("%w: log density at the mode %v is %v", ErrInvalidDensity, mode, logPDF(mode))
+	}
+	d, err := newPDFDistribution(func(x float64) float64 { return math.Exp(logPDF(x) - shift) }, support, mode)
+	if err != nil {
+		return nil, err
+	}
+	d.logPDF, d.logScale = logPDF, shift+math.Log(d.total)
+	return d, nil
+}
+
+// Validate the support, and locate the maximum of pdf (or any increasing function of it) unless the mode is given in opts.
+func pdfMode(pdf func(float64) float64, support [2]float64, opts PDFOptions) (float64, error) {
+	lo, hi := support[0], support[1]
+	if math.IsNaN(lo) || math.IsNaN(hi) || !(lo < hi) {
+		return math.NaN(), fmt.Errorf("%w: [%v, %v]", ErrInvalidSupport, lo, hi)
+	}
+	mode := opts.Mode
+	if !opts.ModeKnown {
+		mode = findMode(pdf, lo, hi, opts.Guess)
+	}
+	if math.IsNaN(mode) || math.IsInf(mode, 0) || mode < lo || mode > hi {
+		return math.NaN(), fmt.Errorf("%w: mode is %v, with support [%v, %v]", ErrNotUnimodal, mode, lo, hi)
+	}
+	return mode, nil
+}
+
+func newPDFDistribution(pdf func(float64) float64, support [2]float64, mode float64) (*pdfDistribution, error) {
+	d := &pdfDistribution{pdf: pdf, lo: support[0], hi: support[1], mode: mode}
+	lower, err := d.boundaries(-1)
+	if err != nil {
+		return nil, err
+	}
+	upper, err := d.boundaries(1)
+	if err != nil {
+		return nil, err
+	}
+	slices.Reverse(lower)
+	d.xs = append(append(lower, mode), upper...)
+	n := len(d.xs) - 1
+	mass := make([]float64, n)
+	for i := range n {
+		mass[i] = d.integrate(d.xs[i], d.xs[i+1])
+		if math.IsNaN(mass[i]) || math.IsInf(mass[i], 0) || mass[i] < 0 {
+			return nil, fmt.Errorf("%w: integral between %v and %v is %v", ErrInvalidDensity, d.xs[i], d.xs[i+1], mass[i])
+		}
+	}
+	// Each cumulative sum starts from its small end, to keep the tails accurate.
+	d.below, d.above = make([]float64, n+1), make([]float64, n+1)
+	for i := range n {
+		d.below[i+1] = d.below[i] + mass[i]
+		d.above[n-i-1] = d.above[n-i] + mass[n-i-1]
+	}
+	d.total = d.below[n]
+	if math.IsInf(d.total, 0) || !(d.total > 0) {
+		return nil, fmt.Errorf("%w: integral over the support is %v", ErrInvalidDensity, d.total)
+	}
+	return d, nil
+}
+
+// The cell boundaries on one side of the mode, in increasing distance from it, ending at the support or where the density vanishes.
+func (d *pdfDistribution) boundaries(direction float64) ([]float64, error) {
+	end := d.hi
+	if direction < 0 {
+		end = d.lo
+	}
+	var xs []float64
+	prev := d.mode
+	add := func(x float64) error {
+		if x == prev {
+			return nil
+		}
+		p := d.pdf(x)
+		if math.IsNaN(p) || math.IsInf(p, 0) || p < 0 {
+			return fmt.Errorf("%w: density at %v is %v", ErrInvalidDensity, x, p)
+		}
+		xs = append(xs, x)
+		prev = x
+		return nil
+	}
+	for k := pdfMinCell; k <= pdfMaxCell; k++ {
+		x := d.mode + direction*math.Exp(float64(k)/pdfCellsPerE)
+		if math.IsInf(x, 0) {
+			break
+		}
+		if (x-end)*direction >= 0 {
+			// The density may be singular at a finite end of the support too, so approach it geometrically.
+			gap := math.Abs(end - prev)
+			for j := 1; j <= -pdfMinCell; j++ {
+				if err := add(end - direction*gap*math.Exp(-float64(j)/pdfCellsPerE)); err != nil {
+					return nil, err
+				}
+			}
+			if err := add(end); err != nil {
+				return nil, err
+			}
+			break
+		}
+		last := prev
+		if err := add(x); err != nil {
+			return nil, err
+		}
+		if d.pdf(x) == 0 && d.integrate(min(last, x), max(last, x)) == 0 {
+			break
+		}
+	}
+	return xs, nil
+}
+
+// The unnormalized mass between a and b, which are within one cell. The ends are never evaluated, as the density may be infinite at the mode.
+func (d *pdfDistribution) integrate(a, b float64) float64 {
+	return quad.GaussLegendre(d.pdf, a, b)
+}
+
+// The mass of d between a and b, by adaptive quadrature of its density. An infinite bound is mapped to a finite one by
+// x = a + w*u/(1-u), with w the distance of a from zero, or one, the scale over which the density is assumed to vary.
+func integrateProb(d Distribution, a, b float64) float64 {
+	logDensity := hasLogProb(d)
+	prob := func(x float64) float64 { return density(d, logDensity, x) }
+	switch {
+	case math.IsInf(a, -1) && math.IsInf(b, 1):
+		mode := d.Mode()
+		return integrateProb(d, a, mode) + integrateProb(d, mode, b)
+	case math.IsInf(b, 1):
+		return quad.IntegrateAbove(prob, a, max(math.Abs(a), 1))
+	case math.IsInf(a, -1):
+		return quad.IntegrateAbove(func(x float64) float64 { return prob(-x) }, -b, max(math.Abs(b), 1))
+	}
+	return quad.Integrate(prob, a, b)
+}
+
+// The index i of the cell containing x, with xs[i] <= x < xs[i+1], for x inside the tabulated range.
+func (d *pdfDistribution) cell(x float64) int {
+	return sort.Search(len(d.xs), func(i int) bool { return d.xs[i] > x }) - 1
+}
+
+func (d *pdfDistribution) Mode() float64 {
+	return d.mode
+}
+
+func (d *pdfDistribution) Prob(x float64) float64 {
+	if x < d.lo || x > d.hi {
+		return 0.0
+	}
+	if d.logPDF != nil {
+		return math.Exp(d.LogProb(x))
+	}
+	return d.pdf(x) / d.total
+}
+
+func (d *pdfDistribution) LogProb(x float64) float64 {
+	if x < d.lo || x > d.hi {
+		return math.Inf(-1)
+	}
+	if d.logPDF != nil {
+		return d.logPDF(x) - d.logScale
+	}
+	return math.Log(d.pdf(x) / d.total)
+}
+
+func (d *pdfDistribution) Survival(x float64) float64 {
+	if math.IsNaN(x) {
+		return math.NaN()
+	}
+	if x <= d.xs[0] {
+		return 1.0
+	}
+	if x >= d.xs[len(d.xs)-1] {
+		return 0.0
+	}
+	i := d.cell(x)
+	return min((d.above[i+1]+d.integrate(x, d.xs[i+1]))/d.total, 1)
+}
+
+func (d *pdfDistribution) CDF(x float64) float64 {
+	if math.IsNaN(x) {
+		return math.NaN()
+	}
+	if x <= d.xs[0] {
+		return 0.0
+	}
+	if x >= d.xs[len(d.xs)-1] {
+		return 1.0
+	}
+	i := d.cell(x)
+	return min((d.below[i]+d.integrate(d.xs[i], x))/d.total, 1)
+}
+
+func (d *pdfDistribution) Quantile(p float64) float64 {
+	if math.IsNaN(p) || p < 0 || p > 1 {
+		return math.NaN()
+	}
+	if p == 0 {
+		return d.lo
+	}
+	if p == 1 {
+		return d.hi
+	}
+	n := len(d.xs) - 1
+	if p < 0.5 {
+		c := p * d.total
+		i := min(sort.Search(n+1, func(i int) bool { return d.below[i] > c })-1, n-1)
+		return searchInterval(d.xs[i], d.xs[i+1], func(x float64) bool { return d.below[i]+d.integrate(d.xs[i], x) >= c })
+	}
+	s := (1 - p) * d.total
+	i := max(sort.Search(n+1, func(i int) bool { return d.above[i] <= s }), 1)
+	return searchInterval(d.xs[i-1], d.xs[i], func(x float64) bool { return d.above[i]+d.integrate(x, d.xs[i]) <= s })
+}
+
+// Locate the maximum of pdf on [lo, hi], by scanning points spread geometrically about guess and the finite ends of the support,
+// and uniformly over a finite support, then refining by golden section search between the neighbours of the best point.
+func findMode(pdf func(float64) float64, lo, hi, guess float64) float64 {
+	guess = min(max(guess, lo), hi)
+	xs := []float64{guess, lo, hi}
+	for k := -80; k <= 120; k++ {
+		w := math.Exp2(float64(k) / 2)
+		xs = append(xs, guess-w, guess+w, lo+w, hi-w)
+		if !math.IsInf(lo, 0) && !math.IsInf(hi, 0) {
+			xs = append(xs, lo+(hi-lo)*w/(1<<60), hi-(hi-lo)*w/(1<<60))
+		}
+	}
+	if !math.IsInf(lo, 0) && !math.IsInf(hi, 0) {
+		for i := range 257 {
+			xs = append(xs, lo+(hi-lo)*float64(i)/256)
+		}
+	}
+	xs = slices.DeleteFunc(xs, func(x float64) bool { return math.IsInf(x, 0) || math.IsNaN(x) || x < lo || x > hi })
+	slices.Sort(xs)
+	xs = slices.Compact(xs)
+	if len(xs) == 0 {
+		return math.NaN()
+	}
+	// A NaN density, as at the end of some supports, never becomes the best.
+	best, bestValue := 0, math.Inf(-1)
+	for i, x := range xs {
+		if v := pdf(x); v > bestValue {
+			best, bestValue = i, v
+		}
+	}
+	a, b := xs[max(best-1, 0)], xs[min(best+1, len(xs)-1)]
+	mode := goldenSection(pdf, a, b, true)
+	// The maximum may be at an end of the support, which golden section search only approaches.
+	for _, x := range []float64{a, xs[best], b} {
+		if pdf(x) > pdf(mode) {
+			mode = x
+		}
+	}
+	return mode
+}
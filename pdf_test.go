@@ -0,0 +1,136 @@
+package ziggurat_test
+
+import (
+	"errors"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/argusdusty/ziggurat"
+	"github.com/vpxyz/xorshift/xoroshiro128plus"
+	"gonum.org/v1/gonum/stat/distuv"
+)
+
+const (
+	PDF_ALPHA     = 0.001
+	PDF_SAMPLES   = 100_000
+	PDF_TOLERANCE = 1e-9
+)
+
+var PDF_DISTRIBUTIONS = []struct {
+	Name      string
+	PDF       func(float64) float64
+	Support   [2]float64
+	Opts      ziggurat.PDFOptions
+	Dist      ziggurat.Distribution // The distribution with the density PDF, for comparison.
+	Moment    func(m uint64) float64
+	MaxMoment uint64
+}{
+	{Name: "Normal", PDF: func(x float64) float64 { return math.Exp(-x * x / 2) }, Support: [2]float64{math.Inf(-1), math.Inf(1)}, Dist: distuv.UnitNormal, Moment: normalMoment, MaxMoment: 4},
+	{Name: "ShiftedNormal", PDF: func(x float64) float64 { return math.Exp(-(x - 30) * (x - 30) / 8) }, Support: [2]float64{math.Inf(-1), math.Inf(1)}, Dist: distuv.Normal{Mu: 30, Sigma: 2}, Moment: scaledNormalMoment(30, 2), MaxMoment: 4},
+	{Name: "Gamma", PDF: distuv.Gamma{Alpha: 2.5, Beta: 1.0}.Prob, Support: [2]float64{0, math.Inf(1)}, Dist: distuv.Gamma{Alpha: 2.5, Beta: 1.0}, Moment: gammaMoment(2.5, 1), MaxMoment: 4},
+	{Name: "SmallGamma", PDF: func(x float64) float64 { return math.Exp(-x) / math.Sqrt(x) }, Support: [2]float64{0, math.Inf(1)}, Dist: distuv.Gamma{Alpha: 0.5, Beta: 1.0}, Moment: gammaMoment(0.5, 1), MaxMoment: 4},
+	{Name: "Beta", PDF: func(x float64) float64 { return x * math.Pow(1-x, 4) }, Support: [2]float64{0, 1}, Dist: distuv.Beta{Alpha: 2, Beta: 5}},
+	{Name: "Cauchy", PDF: func(x float64) float64 { return 1 / (1 + x*x) }, Support: [2]float64{math.Inf(-1), math.Inf(1)}, Dist: distuv.StudentsT{Mu: 0.0, Sigma: 1.0, Nu: 1.0}},
+	{Name: "Gumbel", PDF: distuv.GumbelRight{Mu: -4, Beta: 0.5}.Prob, Support: [2]float64{math.Inf(-1), math.Inf(1)}, Opts: ziggurat.PDFOptions{Guess: 10}, Dist: distuv.GumbelRight{Mu: -4, Beta: 0.5}},
+	{Name: "KnownMode", PDF: func(x float64) float64 { return math.Exp(-x) }, Support: [2]float64{0, math.Inf(1)}, Opts: ziggurat.PDFOptions{Mode: 0, ModeKnown: true}, Dist: distuv.Exponential{Rate: 1}, Moment: gammaMoment(1, 1), MaxMoment: 4},
+}
+
+func TestFromPDF(t *testing.T) {
+	for _, d := range PDF_DISTRIBUTIONS {
+		t.Run(d.Name, func(t *testing.T) {
+			D, err := ziggurat.FromPDF(d.PDF, d.Support, d.Opts)
+			if err != nil {
+				t.Fatalf("FromPDF returned error %v", err)
+			}
+			if got, want := D.Mode(), d.Dist.Mode(); math.Abs(got-want) > 1e-6*math.Max(1, math.Abs(want)) {
+				t.Errorf("Mode() = %v, want %v", got, want)
+			}
+			for _, p := range []float64{1e-12, 1e-6, 0.01, 0.3, 0.5, 0.7, 0.99, 1 - 1e-6} {
+				x := d.Dist.Quantile(p)
+				if got, want := D.Survival(x), d.Dist.Survival(x); math.Abs(got-want) > PDF_TOLERANCE*want {
+					t.Errorf("Survival(%v) = %v, want %v", x, got, want)
+				}
+				if got := D.Quantile(p); math.Abs(got-x) > 1e-6*math.Max(1, math.Abs(x)) {
+					t.Errorf("Quantile(%v) = %v, want %v", p, got, x)
+				}
+			}
+			testDistributionAllRngs(t, d.Dist, d.Moment, d.MaxMoment, PDF_SAMPLES, PDF_ALPHA, func(dist ziggurat.Distribution, src rand.Source) ziggurat.Sampler {
+				return ziggurat.ToZiggurat(D, src)
+			})
+		})
+	}
+}
+
+// Log densities far out of the range of float64 when exponentiated.
+var LOG_PDF_DISTRIBUTIONS = []struct {
+	Name      string
+	LogPDF    func(float64) float64
+	Support   [2]float64
+	Dist      ziggurat.LogDistribution
+	Moment    func(m uint64) float64
+	MaxMoment uint64
+}{
+	{Name: "Normal", LogPDF: func(x float64) float64 { return -(x-2)*(x-2)/2 - 1e4 }, Support: [2]float64{math.Inf(-1), math.Inf(1)}, Dist: distuv.Normal{Mu: 2, Sigma: 1}, Moment: scaledNormalMoment(2, 1), MaxMoment: 4},
+	{Name: "Gamma", LogPDF: func(x float64) float64 { return 99*math.Log(x) - x + 1000 }, Support: [2]float64{0, math.Inf(1)}, Dist: distuv.Gamma{Alpha: 100, Beta: 1}, Moment: gammaMoment(100, 1), MaxMoment: 4},
+	{Name: "SmallGamma", LogPDF: func(x float64) float64 { return -math.Log(x)/2 - x - 1000 }, Support: [2]float64{0, math.Inf(1)}, Dist: distuv.Gamma{Alpha: 0.5, Beta: 1}, Moment: gammaMoment(0.5, 1), MaxMoment: 4},
+}
+
+func TestFromLogPDF(t *testing.T) {
+	for _, d := range LOG_PDF_DISTRIBUTIONS {
+		t.Run(d.Name, func(t *testing.T) {
+			D, err := ziggurat.FromLogPDF(d.LogPDF, d.Support, ziggurat.PDFOptions{})
+			if err != nil {
+				t.Fatalf("FromLogPDF returned error %v", err)
+			}
+			for _, p := range []float64{1e-6, 0.01, 0.5, 0.99, 1 - 1e-6} {
+				x := d.Dist.Quantile(p)
+				if got, want := D.Survival(x), d.Dist.Survival(x); math.Abs(got-want) > PDF_TOLERANCE*want {
+					t.Errorf("Survival(%v) = %v, want %v", x, got, want)
+				}
+				if got, want := D.LogProb(x), d.Dist.LogProb(x); math.Abs(got-want) > PDF_TOLERANCE*math.Max(1, math.Abs(want)) {
+					t.Errorf("LogProb(%v) = %v, want %v", x, got, want)
+				}
+			}
+			testDistributionAllRngs(t, d.Dist, d.Moment, d.MaxMoment, PDF_SAMPLES, PDF_ALPHA, func(dist ziggurat.Distribution, src rand.Source) ziggurat.Sampler {
+				return ziggurat.ToZiggurat(D, src)
+			})
+		})
+	}
+}
+
+func TestInvalidPDF(t *testing.T) {
+	for _, c := range []struct {
+		Name    string
+		PDF     func(float64) float64
+		Support [2]float64
+		Err     error
+	}{
+		{Name: "EmptySupport", PDF: distuv.UnitNormal.Prob, Support: [2]float64{1, 1}, Err: ziggurat.ErrInvalidSupport},
+		{Name: "NaNSupport", PDF: distuv.UnitNormal.Prob, Support: [2]float64{math.NaN(), 1}, Err: ziggurat.ErrInvalidSupport},
+		{Name: "Zero", PDF: func(x float64) float64 { return 0 }, Support: [2]float64{math.Inf(-1), math.Inf(1)}, Err: ziggurat.ErrInvalidDensity},
+		{Name: "NaN", PDF: func(x float64) float64 { return math.NaN() }, Support: [2]float64{0, 1}, Err: ziggurat.ErrInvalidDensity},
+		{Name: "Negative", PDF: func(x float64) float64 { return math.Exp(-x*x/2) - 0.5 }, Support: [2]float64{-2, 2}, Err: ziggurat.ErrInvalidDensity},
+		{Name: "NotIntegrable", PDF: func(x float64) float64 { return 1 }, Support: [2]float64{math.Inf(-1), math.Inf(1)}, Err: ziggurat.ErrInvalidDensity},
+	} {
+		t.Run(c.Name, func(t *testing.T) {
+			if _, err := ziggurat.FromPDF(c.PDF, c.Support, ziggurat.PDFOptions{}); !errors.Is(err, c.Err) {
+				t.Errorf("FromPDF returned error %v, want %v", err, c.Err)
+			}
+		})
+	}
+}
+
+func BenchmarkFromPDF(b *testing.B) {
+	for _, d := range PDF_DISTRIBUTIONS {
+		b.Run(d.Name, func(b *testing.B) {
+			for b.Loop() {
+				D, err := ziggurat.FromPDF(d.PDF, d.Support, d.Opts)
+				if err != nil {
+					b.Fatal(err)
+				}
+				ziggurat.ToZiggurat(D, xoroshiro128plus.NewSource(rand.Int64()))
+			}
+		})
+	}
+}
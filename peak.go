@@ -0,0 +1,117 @@
+package ziggurat
+
+import (
+	"math"
+	"math/rand/v2"
+)
+
+// A power-law envelope C*x^-a, with 0 < a < 1, over the strips of a zero-mode distribution whose density is singular at 0.
+// Near such a peak, each strip is far wider than the rectangle under the density, so rejection from the strip would almost
+// always fail. Instead, the strips from strip upwards sample their wedge from the envelope. Below xMin, where the density
+// may be inaccurate or overflow, its log is extrapolated with the slope measured there.
+type peakEnvelope struct {
+	strip          uint64
+	a, logC        float64
+	logXMin        float64
+	logFMin, slope float64
+}
+
+const (
+	// The fraction of the gap between the exponent measured at the singularity and 1 added to the envelope's exponent,
+	// so that it still bounds the density where the measured exponent is a little low.
+	peakMargin = 0.1
+	// The ratio of the area of a strip's bounding rectangle to the area of the strip, above which the envelope is used.
+	peakMaxRejection = 2
+)
+
+// Fit an envelope to the strips near the peak of d, with splits z and tops t. It returns false if the density doesn't have
+// an integrable power-law singularity at 0, in which case the peak is sampled with the quantile function.
+func newPeakEnvelope(d Distribution, z, t []float64) (peakEnvelope, bool) {
+	n := len(z)
+	strip := int(topStrip(z))
+	for strip > 1 && float64(n)*z[strip-2]*(t[strip-1]-t[strip-2]) > peakMaxRejection {
+		strip--
+	}
+	s := z[strip-1]
+	if !(s > 0) {
+		return peakEnvelope{}, false
+	}
+	// Evaluate the log density at points spaced geometrically towards 0, down to the smallest normal float64.
+	var lxs, ls []float64
+	for k := 0; ; k++ {
+		x := s * math.Exp2(-float64(k)/8)
+		if x < 0x1p-1022 {
+			break
+		}
+		l := logProb(d, x)
+		if math.IsNaN(l) || math.IsInf(l, -1) {
+			return peakEnvelope{}, false
+		}
+		if math.IsInf(l, 1) {
+			break
+		}
+		lxs, ls = append(lxs, math.Log(x)), append(ls, l)
+	}
+	k := len(ls)
+	if k < 2 {
+		return peakEnvelope{}, false
+	}
+	// The exponent of the singularity, from the slope of the log density against log x nearest to 0.
+	slope := (ls[k-1] - ls[k-2]) / (lxs[k-1] - lxs[k-2])
+	if !(slope < 0 && slope > -1) {
+		return peakEnvelope{}, false
+	}
+	e := peakEnvelope{strip: uint64(strip), a: -slope + (1+slope)*peakMargin, logXMin: lxs[k-1], logFMin: ls[k-1], slope: slope}
+	e.logC = math.Inf(-1)
+	for i := range ls {
+		e.logC = max(e.logC, ls[i]+e.a*lxs[i])
+	}
+	e.logC += tailMargin
+	return e, true
+}
+
+// The log density of d at x = exp(lx).
+func (e *peakEnvelope) logProb(d Distribution, lx float64) float64 {
+	if lx < e.logXMin {
+		return e.logFMin + e.slope*(lx-e.logXMin)
+	}
+	return logProb(d, math.Exp(lx))
+}
+
+// Sample from the strip of n between the splits lo and hi, and the densities bottom and top, where lo is 0 and top is
+// infinite for the top strip. Samples too small to represent round to 0.
+func (e *peakEnvelope) sample(src rand.Source, d Distribution, n int, lo, hi, bottom, top float64, c *counters) float64 {
+	if lo > 0 {
+		// The strip is its rectangle under the density, of width lo, and its wedge beside it. Having failed to sample
+		// the rectangle with probability lo/hi, choose it again so that it is chosen in proportion to its area overall.
+		q, r := lo/hi, float64(n)*lo*(top-bottom)
+		if uniform(src) < (r-q)/(1-q) {
+			return lo * uniform(src)
+		}
+	}
+	// Sample the wedge by rejection from the envelope between lo and hi.
+	b := 1 - e.a
+	logLo, logHi := math.Log(lo), math.Log(hi)
+	logBottom := math.Log(bottom)
+	for {
+		c.inc(counterPeakIterations)
+		u := uniform(src)
+		var lx float64
+		if lo > 0 {
+			lx = logLo + math.Log1p(u*math.Expm1(b*(logHi-logLo)))/b
+		} else {
+			if u == 0 {
+				continue
+			}
+			lx = logHi + math.Log(u)/b
+		}
+		l := e.logProb(d, lx)
+		if l <= logBottom {
+			continue
+		}
+		// Accept with probability (f(x) - bottom) / (C*x^-a).
+		if math.Log(uniform(src)) < l+math.Log1p(-math.Exp(logBottom-l))-(e.logC-e.a*lx) {
+			return math.Exp(lx)
+		}
+	}
+}
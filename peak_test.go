@@ -0,0 +1,60 @@
+package ziggurat_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/argusdusty/ziggurat"
+	"github.com/vpxyz/xorshift/xoroshiro128plus"
+)
+
+const (
+	PEAK_EXPONENT = 0.75
+	PEAK_SAMPLES  = 100_000
+)
+
+// The density (1-a)/2*|x|^-a on [-1, 1], capped below 1e-300 so no power law fits the peak, which is then sampled from the quantile function.
+type SymmetricPowerPeak struct{}
+
+func (S SymmetricPowerPeak) Mode() float64 {
+	return 0.0
+}
+
+func (S SymmetricPowerPeak) Prob(x float64) float64 {
+	if x == 0 {
+		return math.Inf(1)
+	}
+	if math.Abs(x) > 1 {
+		return 0.0
+	}
+	return (1 - PEAK_EXPONENT) / 2 * math.Pow(max(math.Abs(x), 1e-300), -PEAK_EXPONENT)
+}
+
+func (S SymmetricPowerPeak) Survival(x float64) float64 {
+	if x < 0 {
+		return 1 - S.Survival(-x)
+	}
+	return (1 - math.Pow(min(x, 1), 1-PEAK_EXPONENT)) / 2
+}
+
+func (S SymmetricPowerPeak) Quantile(p float64) float64 {
+	if p < 0.5 {
+		return -S.Quantile(1 - p)
+	}
+	return math.Pow(2*p-1, 1/(1-PEAK_EXPONENT))
+}
+
+// The symmetric ziggurat must sample both sides of an infinite peak. With two strips, half the samples are from the peak strip.
+func TestSymmetricInfinitePeak(t *testing.T) {
+	Z := ziggurat.ToSymmetricZigguratWithOptions(SymmetricPowerPeak{}, xoroshiro128plus.NewSource(1), ziggurat.Options{Strips: 2})
+	negative := 0
+	for range PEAK_SAMPLES {
+		if Z.Rand() < 0 {
+			negative++
+		}
+	}
+	// Allow five standard deviations of the binomial count.
+	if math.Abs(float64(negative)-PEAK_SAMPLES/2) > 5*math.Sqrt(PEAK_SAMPLES)/2 {
+		t.Errorf("%d of %d samples are negative", negative, PEAK_SAMPLES)
+	}
+}
@@ -7,3 +7,23 @@ type globalRand struct{}
 func (g globalRand) Uint64() uint64 {
 	return rand.Uint64()
 }
+
+// A uniform float64 in [0, 1), equal to rand.New(src).Float64() without allocating the *rand.Rand.
+func uniform(src rand.Source) float64 {
+	return float64(src.Uint64()<<11>>11) / (1 << 53)
+}
+
+// StreamSource returns the source for one of many independent streams of random numbers derived from seed.
+// Each (seed, stream) pair gives a PCG source with a distinct state, scrambled by SplitMix64.
+func StreamSource(seed, stream uint64) rand.Source {
+	return rand.NewPCG(splitMix64(&seed), splitMix64(&stream))
+}
+
+// Advance state and return the next output of the SplitMix64 generator.
+func splitMix64(state *uint64) uint64 {
+	*state += 0x9e3779b97f4a7c15
+	z := *state
+	z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+	z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+	return z ^ (z >> 31)
+}
@@ -0,0 +1,158 @@
+package ziggurat
+
+import "math"
+
+// Stats reports the expected efficiency of a sampler, computed from its tables.
+type Stats struct {
+	// The probability that a sample is accepted on the fast path, from a single random number and a table lookup.
+	FastPath float64
+	// The expected number of evaluations of the density per sample, in wedge rejections and envelope sampling.
+	ProbCalls float64
+	// The probabilities that a sample is drawn from the infinite tail branch, or the infinite peak branch.
+	Tail, Peak float64
+	// The largest relative error of the area under the density in a strip, compared to the 1/n it should have for n strips.
+	MaxStripAreaError float64
+}
+
+func (z *ziggurat) Stats() Stats {
+	return stripStats(z.d, z.stripSplits, z.stripTops, z.tailPrevSplit, z.hasInfiniteTail, z.hasInfinitePeak, &z.tail, &z.peak)
+}
+
+func (z symmetricZiggurat) Stats() Stats {
+	return z.r.Stats()
+}
+
+func (z *flippedZiggurat) Stats() Stats {
+	return z.Sampler.Stats()
+}
+
+func (z *twoPartZiggurat) Stats() Stats {
+	return mixStats([]float64{1 - z.rightSideProb, z.rightSideProb}, []Stats{z.leftSide.Stats(), z.rightSide.Stats()})
+}
+
+func (z *mixtureZiggurat) Stats() Stats {
+	stats := make([]Stats, len(z.parts))
+	for i, part := range z.parts {
+		stats[i] = part.Stats()
+	}
+	return mixStats(z.alias.weights(), stats)
+}
+
+func (z *discreteZiggurat) Stats() Stats {
+	return z.s.Stats()
+}
+
+func (z *ziggurat32) Stats() Stats {
+	z64 := make([]float64, len(z.stripSplits))
+	for i := range z.stripSplits {
+		z64[i] = float64(z.stripSplits[i])
+	}
+	return stripStats(z.d, z64, z.stripTops, float64(z.tailPrevSplit), z.hasInfiniteTail, z.hasInfinitePeak, &z.tail, &z.peak)
+}
+
+func (z symmetricZiggurat32) Stats() Stats {
+	return z.r.Stats()
+}
+
+func (z *twoPartZiggurat32) Stats() Stats {
+	return mixStats([]float64{1 - z.rightSideProb, z.rightSideProb}, []Stats{z.leftSide.Stats(), z.rightSide.Stats()})
+}
+
+// The stats of a sampler picking each of several parts with the given probabilities.
+func mixStats(weights []float64, stats []Stats) Stats {
+	var s Stats
+	for i, w := range weights {
+		s.FastPath += w * stats[i].FastPath
+		s.ProbCalls += w * stats[i].ProbCalls
+		s.Tail += w * stats[i].Tail
+		s.Peak += w * stats[i].Peak
+		s.MaxStripAreaError = max(s.MaxStripAreaError, stats[i].MaxStripAreaError)
+	}
+	return s
+}
+
+// The probability of picking each index.
+func (a aliasTable) weights() []float64 {
+	n := float64(len(a.probs))
+	w := make([]float64, len(a.probs))
+	for i, p := range a.probs {
+		w[i] += p / n
+		w[a.aliases[i]] += (1 - p) / n
+	}
+	return w
+}
+
+// The stats of the strips of a zero-mode distribution d, following the branches of (*ziggurat).sample.
+func stripStats(d Distribution, z, t []float64, tailPrevSplit float64, hasInfiniteTail, hasInfinitePeak bool, tail *tailEnvelope, peak *peakEnvelope) Stats {
+	n := len(z)
+	var s Stats
+	// The area under the density within each strip, between the tops of the strips below and above. The base strip includes the tail.
+	areaBelow := func(i int) float64 {
+		if i < 0 {
+			return 0.0
+		}
+		a := d.Survival(z[i])
+		if z[i] > 0 {
+			a += z[i] * t[i]
+		}
+		if !hasInfiniteTail {
+			a -= d.Survival(tailPrevSplit)
+		}
+		return a
+	}
+	// The strips above the top, split at zero, share its region under the density.
+	top := int(topStrip(z))
+	for i := range top + 1 {
+		prevSplit, bottom := tailPrevSplit, 0.0
+		if i > 0 {
+			prevSplit, bottom = z[i-1], t[i-1]
+		}
+		strips := 1.0
+		if i == top {
+			strips = float64(n - top)
+		}
+		area := areaBelow(i) - areaBelow(i-1)
+		s.MaxStripAreaError = max(s.MaxStripAreaError, math.Abs(area*float64(n)/strips-1))
+		fast := 1.0
+		if prevSplit > 0 {
+			fast = z[i] / prevSplit
+		}
+		s.FastPath += strips * fast / float64(n)
+		slow := strips * (1 - fast) / float64(n)
+		if slow == 0 {
+			continue
+		}
+		// The area of the strip under the density, beside the rectangle the fast path samples.
+		wedge := area
+		if z[i] > 0 {
+			wedge -= z[i] * (t[i] - bottom)
+		}
+		switch {
+		case peak.a > 0 && uint64(i) >= peak.strip:
+			s.Peak += slow
+			b := 1 - peak.a
+			envelope := math.Exp(peak.logC) * (math.Pow(prevSplit, b) - math.Pow(z[i], b)) / b
+			calls := envelope / wedge
+			if z[i] > 0 {
+				// The rectangle may be chosen again, without evaluating the density.
+				r := float64(n) * z[i] * (t[i] - bottom)
+				calls *= 1 - (r-fast)/(1-fast)
+			}
+			s.ProbCalls += slow * calls
+		case i == 0 && hasInfiniteTail:
+			s.Tail += slow
+			if tail.kind != TailQuantile {
+				s.ProbCalls += slow / tail.acceptance(d)
+			}
+		case i == top && hasInfinitePeak:
+			// Quantile sampling below prevSplit, accepting above the strip's bottom.
+			s.Peak += slow
+			s.ProbCalls += slow * (d.Survival(0) - d.Survival(prevSplit)) / wedge
+		default:
+			// Each attempt either takes the fast path, or evaluates the density in the wedge, and repeats on rejection.
+			accept := wedge / ((prevSplit - z[i]) * (t[i] - bottom))
+			s.ProbCalls += slow / (fast + (1-fast)*accept)
+		}
+	}
+	return s
+}
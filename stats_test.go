@@ -0,0 +1,85 @@
+package ziggurat_test
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/argusdusty/ziggurat"
+	"gonum.org/v1/gonum/stat/distuv"
+)
+
+const (
+	STATS_SAMPLES   = 1_000_000
+	STATS_TOLERANCE = 0.02 // The relative error allowed in the measured number of density evaluations.
+)
+
+// A distribution counting evaluations of its density.
+type countedDistribution struct {
+	ziggurat.Distribution
+	calls *int
+}
+
+func (d countedDistribution) Prob(x float64) float64 {
+	*d.calls++
+	return d.Distribution.Prob(x)
+}
+
+var STATS_DISTRIBUTIONS = []struct {
+	Name string
+	Dist ziggurat.Distribution
+	Fn   func(ziggurat.Distribution, rand.Source, ziggurat.Options) ziggurat.Sampler
+}{
+	{Name: "Normal", Dist: distuv.UnitNormal, Fn: ziggurat.ToZigguratWithOptions},
+	{Name: "SymmetricNormal", Dist: distuv.UnitNormal, Fn: ziggurat.ToSymmetricZigguratWithOptions},
+	{Name: "Exponential", Dist: distuv.Exponential{Rate: 1}, Fn: ziggurat.ToZigguratWithOptions},
+	{Name: "Gamma", Dist: distuv.Gamma{Alpha: 0.5, Beta: 1.0}, Fn: ziggurat.ToZigguratWithOptions},
+	{Name: "SmallGamma", Dist: distuv.Gamma{Alpha: 0.05, Beta: 1.0}, Fn: ziggurat.ToZigguratWithOptions},
+	{Name: "SymmetricStudentsT", Dist: distuv.StudentsT{Mu: 0.0, Sigma: 1.0, Nu: 1.0}, Fn: ziggurat.ToSymmetricZigguratWithOptions},
+	{Name: "Mixture", Dist: distuv.UnitNormal, Fn: func(d ziggurat.Distribution, src rand.Source, opts ziggurat.Options) ziggurat.Sampler {
+		// Count the evaluations of both components.
+		exponential := d.(countedDistribution)
+		exponential.Distribution = distuv.Exponential{Rate: 1}
+		return ziggurat.ToMixtureZigguratWithOptions([]float64{1, 3}, []ziggurat.Distribution{d, exponential}, src, opts)
+	}},
+}
+
+// The expected number of density evaluations must match the number made while sampling.
+func TestStats(t *testing.T) {
+	for _, d := range STATS_DISTRIBUTIONS {
+		for _, strips := range []int{16, ziggurat.ZIGGURAT_N} {
+			t.Run(d.Name, func(t *testing.T) {
+				var calls int
+				Z := d.Fn(countedDistribution{d.Dist, &calls}, rand.NewPCG(1, 2), ziggurat.Options{Strips: strips})
+				stats := Z.Stats()
+				if !(stats.FastPath > 0 && stats.FastPath <= 1) || stats.Tail < 0 || stats.Peak < 0 || stats.Tail+stats.Peak > 1-stats.FastPath+1e-12 {
+					t.Errorf("Invalid stats with %d strips: %+v", strips, stats)
+				}
+				if stats.MaxStripAreaError > 1e-9 {
+					t.Errorf("MaxStripAreaError with %d strips = %v", strips, stats.MaxStripAreaError)
+				}
+				calls = 0
+				for range STATS_SAMPLES {
+					Z.Rand()
+				}
+				// Allow for the sampling error of the count, which is roughly Poisson.
+				measured := float64(calls) / STATS_SAMPLES
+				if math.Abs(measured-stats.ProbCalls) > STATS_TOLERANCE*stats.ProbCalls+5*math.Sqrt(stats.ProbCalls/STATS_SAMPLES) {
+					t.Errorf("ProbCalls with %d strips = %v, measured %v", strips, stats.ProbCalls, measured)
+				}
+			})
+		}
+	}
+}
+
+// Fewer strips must be less efficient.
+func TestStatsStrips(t *testing.T) {
+	few := ziggurat.ToZigguratWithOptions(distuv.UnitNormal, nil, ziggurat.Options{Strips: 16}).Stats()
+	many := ziggurat.ToZiggurat(distuv.UnitNormal, nil).Stats()
+	if !(few.FastPath < many.FastPath && few.ProbCalls > many.ProbCalls && few.Tail > many.Tail) {
+		t.Errorf("Stats with 16 strips %+v, not worse than with %d strips %+v", few, ziggurat.ZIGGURAT_N, many)
+	}
+	if many.FastPath < 0.99 || many.Peak != 0 {
+		t.Errorf("Normal stats %+v", many)
+	}
+}
@@ -0,0 +1,537 @@
+package ziggurat
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/bits"
+	"math/rand/v2"
+	"strconv"
+)
+
+const (
+	tablesMagic   = "ZIGT"
+	tablesVersion = 1
+)
+
+// TablesKind is the kind of sampler which Tables describe.
+type TablesKind uint8
+
+const (
+	// TablesZiggurat describes a single ziggurat, with the mode at its peak and the strips to the right.
+	TablesZiggurat TablesKind = iota
+	// TablesSymmetric describes a ziggurat of the right side of a symmetric distribution, mirrored at random.
+	TablesSymmetric
+	// TablesFlipped describes a ziggurat of the distribution reflected about its mode, held in Parts[0].
+	TablesFlipped
+	// TablesTwoPart describes the left and right sides of the mode, held in Parts[0] and Parts[1].
+	TablesTwoPart
+)
+
+var tablesKindNames = [...]string{TablesZiggurat: "ziggurat", TablesSymmetric: "symmetric", TablesFlipped: "flipped", TablesTwoPart: "twoPart"}
+
+func (k TablesKind) String() string {
+	if int(k) >= len(tablesKindNames) {
+		return fmt.Sprintf("TablesKind(%d)", int(k))
+	}
+	return tablesKindNames[k]
+}
+
+// Tables holds the precomputed state of a Sampler, which is expensive to construct.
+// Tables can be serialized with MarshalBinary or json.Marshal, and loaded back into a Sampler by reattaching the original Distribution.
+// The slices are shared with the Sampler, and must not be modified.
+type Tables struct {
+	Kind TablesKind
+	// For ziggurat and symmetric tables, with the mode of the distribution moved to 0.
+	StripSplits     []float64 // Strip i lies under the density below StripSplits[i], which falls to 0 at the peak.
+	StripTops       []float64 // The density at the top of each strip, which is the bottom of the next.
+	TailPrevSplit   float64   // The width of the base strip, whose tail lies beyond StripSplits[0].
+	HasInfinitePeak bool
+	HasInfiniteTail bool
+	Tail            Tail // TailExponential or TailPareto if the infinite tail is sampled by rejection, fitted with TailRate.
+	TailRate        float64
+	// If PeakExponent is nonzero, the wedges of the infinite peak from strip PeakStrip upwards are sampled by rejection from
+	// the envelope exp(PeakLogScale)*x^-PeakExponent. Below exp(PeakLogXMin), the log density is extrapolated from
+	// PeakLogDensity with slope PeakSlope against log x.
+	PeakStrip      int
+	PeakExponent   float64
+	PeakLogScale   float64
+	PeakLogXMin    float64
+	PeakLogDensity float64
+	PeakSlope      float64
+	Offset         float64
+	// For flipped tables.
+	Mode float64
+	// For two-part tables.
+	RightSideProb float64
+	// The flipped ziggurat, or the left and right sides of a two-part ziggurat.
+	Parts []*Tables
+}
+
+// TablesOf returns the precomputed tables of a Sampler built by this package.
+func TablesOf(s Sampler) (*Tables, error) {
+	switch z := s.(type) {
+	case *ziggurat:
+		return z.tables(TablesZiggurat)
+	case symmetricZiggurat:
+		return z.r.tables(TablesSymmetric)
+	case *flippedZiggurat:
+		r, err := TablesOf(z.Sampler)
+		if err != nil {
+			return nil, err
+		}
+		return &Tables{Kind: TablesFlipped, Mode: z.mode, Parts: []*Tables{r}}, nil
+	case *twoPartZiggurat:
+		left, err := TablesOf(z.leftSide)
+		if err != nil {
+			return nil, err
+		}
+		right, err := TablesOf(z.rightSide)
+		if err != nil {
+			return nil, err
+		}
+		return &Tables{Kind: TablesTwoPart, RightSideProb: z.rightSideProb, Parts: []*Tables{left, right}}, nil
+	case *InstrumentedSampler:
+		return TablesOf(z.Sampler)
+	}
+	return nil, fmt.Errorf("ziggurat: cannot take the tables of %T", s)
+}
+
+func (z *ziggurat) tables(kind TablesKind) (*Tables, error) {
+	if z.scale != 1 || z.shift != z.offset {
+		return nil, fmt.Errorf("ziggurat: cannot take the tables of a location-scale view")
+	}
+	return &Tables{Kind: kind, StripSplits: z.stripSplits, StripTops: z.stripTops, TailPrevSplit: z.tailPrevSplit, HasInfinitePeak: z.hasInfinitePeak, HasInfiniteTail: z.hasInfiniteTail, Tail: z.tail.kind, TailRate: z.tail.rate, PeakStrip: int(z.peak.strip), PeakExponent: z.peak.a, PeakLogScale: z.peak.logC, PeakLogXMin: z.peak.logXMin, PeakLogDensity: z.peak.logFMin, PeakSlope: z.peak.slope, Offset: z.offset}, nil
+}
+
+// Sampler reattaches the tables to the distribution they were built from, returning a Sampler equivalent to the original.
+// The distribution is still needed to sample from the tail and peak of the ziggurat.
+func (t *Tables) Sampler(distribution Distribution, src rand.Source) (_ Sampler, err error) {
+	defer recoverDistribution(&err)
+	if src == nil {
+		src = globalRand{}
+	}
+	if err := t.validate(); err != nil {
+		return nil, err
+	}
+	switch t.Kind {
+	case TablesZiggurat:
+		return t.ziggurat(distribution, src)
+	case TablesSymmetric:
+		r, err := t.ziggurat(truncatedBelow(distribution), src)
+		if err != nil {
+			return nil, err
+		}
+		return symmetricZiggurat{r: r}, nil
+	case TablesFlipped:
+		r, err := t.Parts[0].Sampler(flippedDistribution{Distribution: distribution}, src)
+		if err != nil {
+			return nil, err
+		}
+		return &flippedZiggurat{Sampler: r, mode: t.Mode}, nil
+	default:
+		leftSide, err := t.Parts[0].Sampler(truncatedAbove(distribution), src)
+		if err != nil {
+			return nil, err
+		}
+		rightSide, err := t.Parts[1].Sampler(truncatedBelow(distribution), src)
+		if err != nil {
+			return nil, err
+		}
+		return &twoPartZiggurat{rightSideProb: t.RightSideProb, leftSide: leftSide, rightSide: rightSide, src: src}, nil
+	}
+}
+
+func (t *Tables) ziggurat(distribution Distribution, src rand.Source) (*ziggurat, error) {
+	if mode := distribution.Mode(); mode != t.Offset {
+		return nil, fmt.Errorf("%w: tables were built for a distribution with mode %v, not %v", ErrInvalidTables, t.Offset, mode)
+	}
+	d := zeroModeDistribution{Distribution: distribution}
+	var tail tailEnvelope
+	if t.Tail != TailQuantile {
+		x0 := t.StripSplits[0]
+		tail = tailEnvelope{kind: t.Tail, x0: x0, logTop: logProb(d, x0), rate: t.TailRate}
+	}
+	peak := peakEnvelope{strip: uint64(t.PeakStrip), a: t.PeakExponent, logC: t.PeakLogScale, logXMin: t.PeakLogXMin, logFMin: t.PeakLogDensity, slope: t.PeakSlope}
+	// Tables saved without the envelope of their infinite peak fit it again, as they were built with it.
+	if t.HasInfinitePeak && t.PeakExponent == 0 {
+		peak, _ = newPeakEnvelope(d, t.StripSplits, t.StripTops)
+	}
+	return &ziggurat{stripSplits: t.StripSplits, stripTops: t.StripTops, mask: uint64(len(t.StripSplits) - 1), top: topStrip(t.StripSplits), tailPrevSplit: t.TailPrevSplit, hasInfinitePeak: t.HasInfinitePeak, hasInfiniteTail: t.HasInfiniteTail, tail: tail, peak: peak, d: d, offset: t.Offset, scale: 1.0, shift: t.Offset, logDensity: hasLogProb(distribution), src: src}, nil
+}
+
+// Check that the tables have the structure produced by NewZiggurat and NewSymmetricZiggurat.
+func (t *Tables) validate() error {
+	switch t.Kind {
+	case TablesZiggurat, TablesSymmetric:
+		n := len(t.StripSplits)
+		if n < 2 || n > ZIGGURAT_N || bits.OnesCount(uint(n)) != 1 || len(t.StripTops) != n || len(t.Parts) != 0 {
+			return fmt.Errorf("%w: %d strip splits and %d strip tops", ErrInvalidTables, len(t.StripSplits), len(t.StripTops))
+		}
+		if err := t.validateStrips(); err != nil {
+			return err
+		}
+		switch t.Tail {
+		case TailQuantile:
+		case TailExponential, TailPareto:
+			if !t.HasInfiniteTail || !(t.TailRate > 0) || math.IsInf(t.TailRate, 1) || (t.Tail == TailPareto && !(t.TailRate > 1)) {
+				return fmt.Errorf("%w: %v tail with rate %v", ErrInvalidTables, t.Tail, t.TailRate)
+			}
+		default:
+			return fmt.Errorf("%w: unknown %v", ErrInvalidTables, t.Tail)
+		}
+		if t.PeakExponent != 0 {
+			finite := !math.IsNaN(t.PeakLogScale+t.PeakLogXMin+t.PeakLogDensity) && !math.IsInf(t.PeakLogScale+t.PeakLogXMin+t.PeakLogDensity, 0)
+			if !t.HasInfinitePeak || !(t.PeakExponent > 0 && t.PeakExponent < 1) || !(t.PeakSlope > -1 && t.PeakSlope < 0) || t.PeakStrip < 1 || t.PeakStrip >= n || !finite {
+				return fmt.Errorf("%w: peak envelope with exponent %v from strip %d", ErrInvalidTables, t.PeakExponent, t.PeakStrip)
+			}
+		}
+		return nil
+	case TablesFlipped:
+		if len(t.Parts) != 1 || t.Parts[0] == nil || t.Parts[0].Kind != TablesZiggurat {
+			return fmt.Errorf("%w: flipped tables must contain one ziggurat", ErrInvalidTables)
+		}
+		if math.IsNaN(t.Mode) || math.IsInf(t.Mode, 0) {
+			return fmt.Errorf("%w: flipped about %v", ErrInvalidTables, t.Mode)
+		}
+	case TablesTwoPart:
+		if len(t.Parts) != 2 || t.Parts[0] == nil || t.Parts[1] == nil || (t.Parts[0].Kind != TablesZiggurat && t.Parts[0].Kind != TablesFlipped) || (t.Parts[1].Kind != TablesZiggurat && t.Parts[1].Kind != TablesFlipped) {
+			return fmt.Errorf("%w: two-part tables must contain two ziggurats", ErrInvalidTables)
+		}
+		if !(t.RightSideProb > 0 && t.RightSideProb < 1) {
+			return fmt.Errorf("%w: right side has probability %v", ErrInvalidTables, t.RightSideProb)
+		}
+	default:
+		return fmt.Errorf("%w: unknown kind %d", ErrInvalidTables, t.Kind)
+	}
+	for _, part := range t.Parts {
+		if err := part.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Check the values of the strips, as toZiggurat builds them. The splits are finite, and don't increase toward the peak,
+// where the last is zero. They may repeat, as for a flat density. The tops are finite and don't decrease, except that the
+// last is infinite for an infinite peak, as are those of the strips that share it, split at zero.
+func (t *Tables) validateStrips() error {
+	z, top, n := t.StripSplits, t.StripTops, len(t.StripSplits)
+	for i := range z {
+		if math.IsNaN(z[i]) || math.IsInf(z[i], 0) || z[i] < 0 || (i > 0 && z[i] > z[i-1]) {
+			return fmt.Errorf("%w: strip %d split at %v", ErrInvalidTables, i, z[i])
+		}
+		if math.IsNaN(top[i]) || top[i] < 0 || (math.IsInf(top[i], 1) && z[i] > 0) || (i > 0 && top[i] < top[i-1]) || (i > 0 && z[i-1] == 0 && top[i] != top[i-1]) {
+			return fmt.Errorf("%w: strip %d has top %v", ErrInvalidTables, i, top[i])
+		}
+	}
+	if z[n-1] != 0 {
+		return fmt.Errorf("%w: last strip split at %v, not the peak", ErrInvalidTables, z[n-1])
+	}
+	if math.IsInf(top[n-1], 1) != t.HasInfinitePeak {
+		return fmt.Errorf("%w: peak has top %v", ErrInvalidTables, top[n-1])
+	}
+	if math.IsNaN(t.TailPrevSplit) || math.IsInf(t.TailPrevSplit, 0) || t.TailPrevSplit < z[0] {
+		return fmt.Errorf("%w: tail ends at %v, before the base strip at %v", ErrInvalidTables, t.TailPrevSplit, z[0])
+	}
+	if math.IsNaN(t.Offset) || math.IsInf(t.Offset, 0) {
+		return fmt.Errorf("%w: offset %v", ErrInvalidTables, t.Offset)
+	}
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (t *Tables) MarshalBinary() ([]byte, error) {
+	if err := t.validate(); err != nil {
+		return nil, err
+	}
+	return t.appendBinary(append([]byte(tablesMagic), tablesVersion)), nil
+}
+
+func (t *Tables) appendBinary(b []byte) []byte {
+	b = append(b, byte(t.Kind))
+	switch t.Kind {
+	case TablesZiggurat, TablesSymmetric:
+		b = binary.LittleEndian.AppendUint32(b, uint32(len(t.StripSplits)))
+		for _, x := range t.StripSplits {
+			b = binary.LittleEndian.AppendUint64(b, math.Float64bits(x))
+		}
+		for _, x := range t.StripTops {
+			b = binary.LittleEndian.AppendUint64(b, math.Float64bits(x))
+		}
+		b = binary.LittleEndian.AppendUint64(b, math.Float64bits(t.TailPrevSplit))
+		b = binary.LittleEndian.AppendUint64(b, math.Float64bits(t.Offset))
+		var flags byte
+		if t.HasInfinitePeak {
+			flags |= 1
+		}
+		if t.HasInfiniteTail {
+			flags |= 2
+		}
+		if t.Tail != TailQuantile {
+			flags |= 4
+		}
+		if t.PeakExponent != 0 {
+			flags |= 8
+		}
+		b = append(b, flags)
+		if t.Tail != TailQuantile {
+			b = append(b, byte(t.Tail))
+			b = binary.LittleEndian.AppendUint64(b, math.Float64bits(t.TailRate))
+		}
+		if t.PeakExponent != 0 {
+			b = binary.LittleEndian.AppendUint32(b, uint32(t.PeakStrip))
+			for _, x := range []float64{t.PeakExponent, t.PeakLogScale, t.PeakLogXMin, t.PeakLogDensity, t.PeakSlope} {
+				b = binary.LittleEndian.AppendUint64(b, math.Float64bits(x))
+			}
+		}
+	case TablesFlipped:
+		b = binary.LittleEndian.AppendUint64(b, math.Float64bits(t.Mode))
+	case TablesTwoPart:
+		b = binary.LittleEndian.AppendUint64(b, math.Float64bits(t.RightSideProb))
+	}
+	for _, part := range t.Parts {
+		b = part.appendBinary(b)
+	}
+	return b
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (t *Tables) UnmarshalBinary(data []byte) error {
+	if len(data) < len(tablesMagic)+1 || string(data[:len(tablesMagic)]) != tablesMagic {
+		return fmt.Errorf("%w: missing header", ErrInvalidTables)
+	}
+	if data[len(tablesMagic)] != tablesVersion {
+		return fmt.Errorf("%w: unsupported version %d", ErrInvalidTables, data[len(tablesMagic)])
+	}
+	var u Tables
+	rest, err := u.readBinary(data[len(tablesMagic)+1:], 0)
+	if err != nil {
+		return err
+	}
+	if len(rest) != 0 {
+		return fmt.Errorf("%w: %d trailing bytes", ErrInvalidTables, len(rest))
+	}
+	if err := u.validate(); err != nil {
+		return err
+	}
+	*t = u
+	return nil
+}
+
+func (t *Tables) readBinary(b []byte, depth int) ([]byte, error) {
+	errShort := fmt.Errorf("%w: unexpected end of data", ErrInvalidTables)
+	// Two-part tables nest at most a flipped ziggurat on each side.
+	if depth > 2 {
+		return nil, fmt.Errorf("%w: tables nested too deeply", ErrInvalidTables)
+	}
+	if len(b) < 1 {
+		return nil, errShort
+	}
+	t.Kind, b = TablesKind(b[0]), b[1:]
+	readFloat := func() float64 {
+		x := math.Float64frombits(binary.LittleEndian.Uint64(b))
+		b = b[8:]
+		return x
+	}
+	numParts := 0
+	switch t.Kind {
+	case TablesZiggurat, TablesSymmetric:
+		if len(b) < 4 {
+			return nil, errShort
+		}
+		n := int(binary.LittleEndian.Uint32(b))
+		b = b[4:]
+		if n > ZIGGURAT_N {
+			return nil, fmt.Errorf("%w: %d strips", ErrInvalidTables, n)
+		}
+		if len(b) < 16*n+17 {
+			return nil, errShort
+		}
+		t.StripSplits, t.StripTops = make([]float64, n), make([]float64, n)
+		for i := range t.StripSplits {
+			t.StripSplits[i] = readFloat()
+		}
+		for i := range t.StripTops {
+			t.StripTops[i] = readFloat()
+		}
+		t.TailPrevSplit = readFloat()
+		t.Offset = readFloat()
+		t.HasInfinitePeak, t.HasInfiniteTail = b[0]&1 != 0, b[0]&2 != 0
+		hasTailEnvelope, hasPeakEnvelope := b[0]&4 != 0, b[0]&8 != 0
+		b = b[1:]
+		if hasTailEnvelope {
+			if len(b) < 9 {
+				return nil, errShort
+			}
+			t.Tail, b = Tail(b[0]), b[1:]
+			t.TailRate = readFloat()
+		}
+		if hasPeakEnvelope {
+			if len(b) < 44 {
+				return nil, errShort
+			}
+			t.PeakStrip, b = int(binary.LittleEndian.Uint32(b)), b[4:]
+			t.PeakExponent, t.PeakLogScale, t.PeakLogXMin, t.PeakLogDensity, t.PeakSlope = readFloat(), readFloat(), readFloat(), readFloat(), readFloat()
+		}
+	case TablesFlipped:
+		if len(b) < 8 {
+			return nil, errShort
+		}
+		t.Mode = readFloat()
+		numParts = 1
+	case TablesTwoPart:
+		if len(b) < 8 {
+			return nil, errShort
+		}
+		t.RightSideProb = readFloat()
+		numParts = 2
+	default:
+		return nil, fmt.Errorf("%w: unknown kind %d", ErrInvalidTables, t.Kind)
+	}
+	for range numParts {
+		part := &Tables{}
+		var err error
+		if b, err = part.readBinary(b, depth+1); err != nil {
+			return nil, err
+		}
+		t.Parts = append(t.Parts, part)
+	}
+	return b, nil
+}
+
+// A float64 which encodes infinities and NaN as JSON strings.
+type jsonFloat float64
+
+func (f jsonFloat) MarshalJSON() ([]byte, error) {
+	if math.IsInf(float64(f), 0) || math.IsNaN(float64(f)) {
+		return json.Marshal(strconv.FormatFloat(float64(f), 'g', -1, 64))
+	}
+	return json.Marshal(float64(f))
+}
+
+func (f *jsonFloat) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		x, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return fmt.Errorf("%w: %v", ErrInvalidTables, err)
+		}
+		*f = jsonFloat(x)
+		return nil
+	}
+	return json.Unmarshal(data, (*float64)(f))
+}
+
+type tablesJSON struct {
+	Kind            string        `json:"kind"`
+	StripSplits     []jsonFloat   `json:"stripSplits,omitempty"`
+	StripTops       []jsonFloat   `json:"stripTops,omitempty"`
+	TailPrevSplit   jsonFloat     `json:"tailPrevSplit,omitempty"`
+	HasInfinitePeak bool          `json:"hasInfinitePeak,omitempty"`
+	HasInfiniteTail bool          `json:"hasInfiniteTail,omitempty"`
+	Tail            string        `json:"tail,omitempty"`
+	TailRate        jsonFloat     `json:"tailRate,omitempty"`
+	PeakStrip       int           `json:"peakStrip,omitempty"`
+	PeakExponent    jsonFloat     `json:"peakExponent,omitempty"`
+	PeakLogScale    jsonFloat     `json:"peakLogScale,omitempty"`
+	PeakLogXMin     jsonFloat     `json:"peakLogXMin,omitempty"`
+	PeakLogDensity  jsonFloat     `json:"peakLogDensity,omitempty"`
+	PeakSlope       jsonFloat     `json:"peakSlope,omitempty"`
+	Offset          jsonFloat     `json:"offset,omitempty"`
+	Mode            jsonFloat     `json:"mode,omitempty"`
+	RightSideProb   jsonFloat     `json:"rightSideProb,omitempty"`
+	Parts           []*tablesJSON `json:"parts,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t *Tables) MarshalJSON() ([]byte, error) {
+	if err := t.validate(); err != nil {
+		return nil, err
+	}
+	return json.Marshal(t.toJSON())
+}
+
+func (t *Tables) toJSON() *tablesJSON {
+	j := &tablesJSON{Kind: tablesKindNames[t.Kind], TailPrevSplit: jsonFloat(t.TailPrevSplit), HasInfinitePeak: t.HasInfinitePeak, HasInfiniteTail: t.HasInfiniteTail, Offset: jsonFloat(t.Offset), Mode: jsonFloat(t.Mode), RightSideProb: jsonFloat(t.RightSideProb)}
+	if t.Tail != TailQuantile {
+		j.Tail, j.TailRate = t.Tail.String(), jsonFloat(t.TailRate)
+	}
+	if t.PeakExponent != 0 {
+		j.PeakStrip, j.PeakExponent, j.PeakLogScale, j.PeakLogXMin, j.PeakLogDensity, j.PeakSlope = t.PeakStrip, jsonFloat(t.PeakExponent), jsonFloat(t.PeakLogScale), jsonFloat(t.PeakLogXMin), jsonFloat(t.PeakLogDensity), jsonFloat(t.PeakSlope)
+	}
+	for _, x := range t.StripSplits {
+		j.StripSplits = append(j.StripSplits, jsonFloat(x))
+	}
+	for _, x := range t.StripTops {
+		j.StripTops = append(j.StripTops, jsonFloat(x))
+	}
+	for _, part := range t.Parts {
+		j.Parts = append(j.Parts, part.toJSON())
+	}
+	return j
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (t *Tables) UnmarshalJSON(data []byte) error {
+	var j tablesJSON
+	if err := json.Unmarshal(data, &j); err != nil {
+		return err
+	}
+	var u Tables
+	if err := u.fromJSON(&j); err != nil {
+		return err
+	}
+	if err := u.validate(); err != nil {
+		return err
+	}
+	*t = u
+	return nil
+}
+
+func (t *Tables) fromJSON(j *tablesJSON) error {
+	if j == nil {
+		return fmt.Errorf("%w: missing part", ErrInvalidTables)
+	}
+	kind := -1
+	for k, name := range tablesKindNames {
+		if name == j.Kind {
+			kind = k
+		}
+	}
+	if kind < 0 {
+		return fmt.Errorf("%w: unknown kind %q", ErrInvalidTables, j.Kind)
+	}
+	*t = Tables{Kind: TablesKind(kind), TailPrevSplit: float64(j.TailPrevSplit), HasInfinitePeak: j.HasInfinitePeak, HasInfiniteTail: j.HasInfiniteTail, Offset: float64(j.Offset), Mode: float64(j.Mode), RightSideProb: float64(j.RightSideProb)}
+	if j.Tail != "" {
+		t.Tail = -1
+		for k, name := range tailNames {
+			if name == j.Tail {
+				t.Tail = Tail(k)
+			}
+		}
+		t.TailRate = float64(j.TailRate)
+	}
+	t.PeakStrip, t.PeakExponent, t.PeakLogScale, t.PeakLogXMin, t.PeakLogDensity, t.PeakSlope = j.PeakStrip, float64(j.PeakExponent), float64(j.PeakLogScale), float64(j.PeakLogXMin), float64(j.PeakLogDensity), float64(j.PeakSlope)
+	if len(j.StripSplits) > 0 {
+		t.StripSplits = make([]float64, len(j.StripSplits))
+		for i, x := range j.StripSplits {
+			t.StripSplits[i] = float64(x)
+		}
+	}
+	if len(j.StripTops) > 0 {
+		t.StripTops = make([]float64, len(j.StripTops))
+		for i, x := range j.StripTops {
+			t.StripTops[i] = float64(x)
+		}
+	}
+	for _, p := range j.Parts {
+		part := &Tables{}
+		if err := part.fromJSON(p); err != nil {
+			return err
+		}
+		t.Parts = append(t.Parts, part)
+	}
+	return nil
+}
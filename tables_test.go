@@ -0,0 +1,145 @@
+package ziggurat_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/argusdusty/ziggurat"
+	"github.com/vpxyz/xorshift/xoroshiro128plus"
+	"gonum.org/v1/gonum/stat/distuv"
+)
+
+// A loaded sampler must produce exactly the same samples as the sampler its tables were taken from.
+func testTablesRoundTrip(t *testing.T, Z ziggurat.Sampler, dist ziggurat.Distribution, marshal func(*ziggurat.Tables) ([]byte, error), unmarshal func([]byte, *ziggurat.Tables) error) {
+	tables, err := ziggurat.TablesOf(Z)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := marshal(tables)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var loaded ziggurat.Tables
+	if err := unmarshal(data, &loaded); err != nil {
+		t.Fatal(err)
+	}
+	L, err := loaded.Sampler(dist, xoroshiro128plus.NewSource(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range FILL_SAMPLES {
+		if got, want := L.Rand(), Z.Rand(); got != want {
+			t.Fatalf("Loaded sample %d = %v, original sample = %v", i, got, want)
+		}
+	}
+}
+
+func TestTables(t *testing.T) {
+	for _, d := range FILL_DISTRIBUTIONS {
+		t.Run(d.Name, func(t *testing.T) {
+			t.Run("encoding=binary", func(t *testing.T) {
+				testTablesRoundTrip(t, d.Fn(d.Dist, xoroshiro128plus.NewSource(1)), d.Dist, (*ziggurat.Tables).MarshalBinary, func(data []byte, tables *ziggurat.Tables) error { return tables.UnmarshalBinary(data) })
+			})
+			t.Run("encoding=json", func(t *testing.T) {
+				testTablesRoundTrip(t, d.Fn(d.Dist, xoroshiro128plus.NewSource(1)), d.Dist, func(tables *ziggurat.Tables) ([]byte, error) { return json.Marshal(tables) }, func(data []byte, tables *ziggurat.Tables) error { return json.Unmarshal(data, tables) })
+			})
+		})
+	}
+}
+
+// The envelope of an infinite peak is stored with the tables, so it needn't be fitted again.
+func TestTablesPeak(t *testing.T) {
+	tables, err := ziggurat.TablesOf(ziggurat.ToZiggurat(distuv.Gamma{Alpha: 0.05, Beta: 1}, nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !(tables.PeakExponent > 0 && tables.PeakExponent < 1) || tables.PeakStrip < 1 || tables.PeakStrip >= len(tables.StripSplits) {
+		t.Errorf("Peak envelope with exponent %v from strip %d", tables.PeakExponent, tables.PeakStrip)
+	}
+}
+
+func TestInvalidTables(t *testing.T) {
+	tables, err := ziggurat.TablesOf(ziggurat.ToZiggurat(distuv.UnitNormal, nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := tables.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	symmetric, err := ziggurat.TablesOf(ziggurat.ToSymmetricZiggurat(distuv.UnitNormal, nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	symmetricData, err := symmetric.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	z, top := symmetric.StripSplits, symmetric.StripTops
+	var loaded ziggurat.Tables
+	for _, bad := range [][]byte{
+		nil, []byte("ZIGT"), append([]byte("ZIGT\x02"), data[5:]...), data[:len(data)-1], append(data, 0),
+		replaceFloat(data, 0.5, 1.5), replaceFloat(symmetricData, z[0], math.NaN()), replaceFloat(symmetricData, z[1], 2*z[0]),
+		replaceFloat(symmetricData, z[len(z)-1], z[len(z)-2]), replaceFloat(symmetricData, top[0], -1),
+		replaceFloat(symmetricData, top[1], top[0]/2), replaceFloat(symmetricData, top[len(top)-1], math.Inf(1)),
+	} {
+		if err := loaded.UnmarshalBinary(bad); !errors.Is(err, ziggurat.ErrInvalidTables) {
+			t.Errorf("UnmarshalBinary of %d bytes returned error %v, expected %v", len(bad), err, ziggurat.ErrInvalidTables)
+		}
+	}
+	for _, bad := range []string{`{"kind":"pyramid"}`, `{"kind":"ziggurat","stripSplits":[1,0],"stripTops":[1]}`, `{"kind":"twoPart","parts":[{"kind":"ziggurat","stripSplits":[1,0],"stripTops":[0,1]}]}`,
+		`{"kind":"ziggurat","stripSplits":["NaN",0],"stripTops":[0,1]}`,
+		`{"kind":"ziggurat","stripSplits":[1,2],"stripTops":[0,1],"tailPrevSplit":2}`,
+		`{"kind":"ziggurat","stripSplits":[1,0],"stripTops":[1,0.5],"tailPrevSplit":1}`,
+		`{"kind":"ziggurat","stripSplits":[1,0],"stripTops":[0,1],"tailPrevSplit":0.5}`,
+		`{"kind":"ziggurat","stripSplits":[1,0],"stripTops":[0,"+Inf"],"tailPrevSplit":1}`,
+		`{"kind":"ziggurat","stripSplits":[1,0],"stripTops":[0,1],"tailPrevSplit":1,"peakStrip":1,"peakExponent":0.5}`,
+		`{"kind":"twoPart","rightSideProb":2,"parts":[{"kind":"ziggurat","stripSplits":[1,0],"stripTops":[0,1],"tailPrevSplit":1},{"kind":"ziggurat","stripSplits":[1,0],"stripTops":[0,1],"tailPrevSplit":1}]}`,
+	} {
+		if err := json.Unmarshal([]byte(bad), &loaded); !errors.Is(err, ziggurat.ErrInvalidTables) {
+			t.Errorf("UnmarshalJSON of %s returned error %v, expected %v", bad, err, ziggurat.ErrInvalidTables)
+		}
+	}
+	if err := loaded.UnmarshalBinary(data); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := loaded.Sampler(distuv.Normal{Mu: 1, Sigma: 1}, nil); !errors.Is(err, ziggurat.ErrInvalidTables) {
+		t.Errorf("Sampler with the wrong distribution returned error %v, expected %v", err, ziggurat.ErrInvalidTables)
+	}
+}
+
+// Replace the first encoding of old in data with new.
+func replaceFloat(data []byte, old, new float64) []byte {
+	return bytes.Replace(data, binary.LittleEndian.AppendUint64(nil, math.Float64bits(old)), binary.LittleEndian.AppendUint64(nil, math.Float64bits(new)), 1)
+}
+
+func BenchmarkTables(b *testing.B) {
+	tables, err := ziggurat.TablesOf(ziggurat.ToZiggurat(distuv.Beta{Alpha: 4, Beta: 4}, nil))
+	if err != nil {
+		b.Fatal(err)
+	}
+	data, err := tables.MarshalBinary()
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.Run("method=Construct", func(b *testing.B) {
+		for b.Loop() {
+			ziggurat.ToZiggurat(distuv.Beta{Alpha: 4, Beta: 4}, nil)
+		}
+	})
+	b.Run("method=Load", func(b *testing.B) {
+		for b.Loop() {
+			var loaded ziggurat.Tables
+			if err := loaded.UnmarshalBinary(data); err != nil {
+				b.Fatal(err)
+			}
+			if _, err := loaded.Sampler(distuv.Beta{Alpha: 4, Beta: 4}, nil); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
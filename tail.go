@@ -0,0 +1,113 @@
+package ziggurat
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+)
+
+// An envelope for rejection sampling from the tail of a zero-mode distribution beyond x0, where the log density is logTop.
+// The exponential envelope is logTop - rate*(x-x0), and the Pareto envelope is logTop - rate*log(x/x0).
+type tailEnvelope struct {
+	kind       Tail
+	x0, logTop float64
+	rate       float64
+}
+
+const (
+	// The margin left below the fitted rate, as the tightest bound may fall between the points it is fitted at.
+	tailMargin = 1e-3
+	// The smallest acceptance rate of a usable envelope.
+	minTailAcceptance = 0.01
+)
+
+// Fit an envelope of the given kind to the tail of d beyond x0. TailQuantile returns the zero envelope, which is unused.
+func newTailEnvelope(kind Tail, d Distribution, x0 float64) (tailEnvelope, error) {
+	if kind == TailQuantile {
+		return tailEnvelope{}, nil
+	}
+	logTop := logProb(d, x0)
+	if math.IsNaN(logTop) || math.IsInf(logTop, 0) || !(x0 > 0) {
+		return tailEnvelope{}, fmt.Errorf("%w: density at the base strip %v is %v", ErrInvalidDensity, x0, math.Exp(logTop))
+	}
+	// Each rate is the smallest over points spaced geometrically beyond x0 of the drop in the log density, relative to the
+	// distance, or to the log of the ratio, from x0.
+	exponential, pareto := math.Inf(1), math.Inf(1)
+	for k := -20 * 8; ; k++ {
+		w := x0 * math.Exp2(float64(k)/8)
+		x := x0 + w
+		if math.IsInf(x, 1) {
+			break
+		}
+		l := logProb(d, x)
+		if math.IsNaN(l) || math.IsInf(l, 1) {
+			return tailEnvelope{}, fmt.Errorf("%w: density at %v is %v", ErrInvalidDensity, x, math.Exp(l))
+		}
+		if math.IsInf(l, -1) {
+			break
+		}
+		exponential = min(exponential, (logTop-l)/w)
+		pareto = min(pareto, (logTop-l)/math.Log1p(w/x0))
+	}
+	exponential *= 1 - tailMargin
+	pareto *= 1 - tailMargin
+	// The acceptance rate of each envelope is the mass of the tail relative to the area under the envelope.
+	// Where the rate tends to zero beyond the last point, the fitted rate is tiny, and so is the acceptance rate.
+	mass := d.Survival(x0) / math.Exp(logTop)
+	exponentialAcceptance, paretoAcceptance := 0.0, 0.0
+	if exponential > 0 && !math.IsInf(exponential, 1) {
+		exponentialAcceptance = mass * exponential
+	}
+	if pareto > 1 && !math.IsInf(pareto, 1) {
+		paretoAcceptance = mass * (pareto - 1) / x0
+	}
+	if kind == TailAuto {
+		switch {
+		case max(exponentialAcceptance, paretoAcceptance) < minTailAcceptance:
+			return tailEnvelope{}, nil
+		case exponentialAcceptance >= paretoAcceptance:
+			kind = TailExponential
+		default:
+			kind = TailPareto
+		}
+	}
+	switch kind {
+	case TailExponential:
+		if !(exponentialAcceptance >= minTailAcceptance) {
+			return tailEnvelope{}, fmt.Errorf("%w: no exponential envelope bounds the tail beyond %v", ErrInvalidOptions, x0)
+		}
+		return tailEnvelope{kind: kind, x0: x0, logTop: logTop, rate: exponential}, nil
+	case TailPareto:
+		if !(paretoAcceptance >= minTailAcceptance) {
+			return tailEnvelope{}, fmt.Errorf("%w: no Pareto envelope bounds the tail beyond %v", ErrInvalidOptions, x0)
+		}
+		return tailEnvelope{kind: kind, x0: x0, logTop: logTop, rate: pareto}, nil
+	}
+	return tailEnvelope{}, fmt.Errorf("%w: unknown %v", ErrInvalidOptions, kind)
+}
+
+// Sample from the tail of d beyond x0, by rejection from the envelope.
+func (e *tailEnvelope) sample(src rand.Source, d Distribution) float64 {
+	for {
+		var x, logEnvelope float64
+		if e.kind == TailExponential {
+			x = e.x0 - math.Log1p(-uniform(src))/e.rate
+			logEnvelope = e.logTop - e.rate*(x-e.x0)
+		} else {
+			x = e.x0 * math.Pow(1-uniform(src), -1/(e.rate-1))
+			logEnvelope = e.logTop - e.rate*math.Log(x/e.x0)
+		}
+		if math.Log(uniform(src)) < logProb(d, x)-logEnvelope {
+			return x
+		}
+	}
+}
+
+// The probability that a sample from the envelope is accepted, the mass of the tail relative to the area under the envelope.
+func (e *tailEnvelope) acceptance(d Distribution) float64 {
+	mass := d.Survival(e.x0) / math.Exp(e.logTop)
+	if e.kind == TailExponential {
+		return mass * e.rate
+	}
+	return mass * (e.rate - 1) / e.x0
+}
@@ -0,0 +1,90 @@
+package ziggurat_test
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/argusdusty/ziggurat"
+	"github.com/vpxyz/xorshift/xoroshiro128plus"
+	"gonum.org/v1/gonum/stat/distuv"
+)
+
+const (
+	TAIL_ALPHA   = 0.001
+	TAIL_SAMPLES = 100_000
+)
+
+// A half-normal without a usable quantile function, beyond the end of its support.
+type NoQuantile struct {
+	UnitHalfNormal
+}
+
+func (N NoQuantile) Quantile(p float64) float64 {
+	if p == 1 {
+		return math.Inf(1)
+	}
+	return math.NaN()
+}
+
+func withTail(fn func(ziggurat.Distribution, rand.Source, ziggurat.Options) ziggurat.Sampler, tail ziggurat.Tail) func(ziggurat.Distribution, rand.Source) ziggurat.Sampler {
+	return func(dist ziggurat.Distribution, src rand.Source) ziggurat.Sampler {
+		return fn(dist, src, ziggurat.Options{Tail: tail})
+	}
+}
+
+var TAIL_DISTRIBUTIONS = []struct {
+	Name      string
+	Dist      ziggurat.Distribution
+	Fn        func(ziggurat.Distribution, rand.Source) ziggurat.Sampler
+	Moment    func(m uint64) float64
+	MaxMoment uint64
+}{
+	{Name: "Normal", Dist: distuv.UnitNormal, Fn: withTail(ziggurat.ToZigguratWithOptions, ziggurat.TailAuto), Moment: normalMoment, MaxMoment: 4},
+	{Name: "SymmetricNormal", Dist: distuv.UnitNormal, Fn: withTail(ziggurat.ToSymmetricZigguratWithOptions, ziggurat.TailExponential), Moment: normalMoment, MaxMoment: 4},
+	{Name: "NoQuantile", Dist: NoQuantile{}, Fn: withTail(ziggurat.ToZigguratWithOptions, ziggurat.TailAuto), Moment: halfNormalMoment, MaxMoment: 4},
+	{Name: "Gamma", Dist: distuv.Gamma{Alpha: 0.5, Beta: 1.0}, Fn: withTail(ziggurat.ToZigguratWithOptions, ziggurat.TailAuto), Moment: gammaMoment(0.5, 1), MaxMoment: 4},
+	{Name: "GammaPareto", Dist: distuv.Gamma{Alpha: 2.5, Beta: 1.0}, Fn: withTail(ziggurat.ToZigguratWithOptions, ziggurat.TailPareto), Moment: gammaMoment(2.5, 1), MaxMoment: 4},
+	{Name: "StudentsT", Dist: distuv.StudentsT{Mu: 0.0, Sigma: 1.0, Nu: 5.0}, Fn: withTail(ziggurat.ToSymmetricZigguratWithOptions, ziggurat.TailPareto), Moment: func(m uint64) float64 { return []float64{1, 0, 5.0 / 3.0}[m] }, MaxMoment: 1},
+	{Name: "Cauchy", Dist: distuv.StudentsT{Mu: 0.0, Sigma: 1.0, Nu: 1.0}, Fn: withTail(ziggurat.ToSymmetricZigguratWithOptions, ziggurat.TailAuto)},
+}
+
+func TestTail(t *testing.T) {
+	for _, d := range TAIL_DISTRIBUTIONS {
+		t.Run(d.Name, func(t *testing.T) {
+			testDistributionAllRngs(t, d.Dist, d.Moment, d.MaxMoment, TAIL_SAMPLES, TAIL_ALPHA, d.Fn)
+		})
+	}
+}
+
+// The fitted envelope must survive a round trip through the tables.
+func TestTailTables(t *testing.T) {
+	for _, d := range TAIL_DISTRIBUTIONS {
+		t.Run(d.Name, func(t *testing.T) {
+			testTablesRoundTrip(t, d.Fn(d.Dist, xoroshiro128plus.NewSource(1)), d.Dist, func(tables *ziggurat.Tables) ([]byte, error) { return json.Marshal(tables) }, func(data []byte, tables *ziggurat.Tables) error { return json.Unmarshal(data, tables) })
+			testTablesRoundTrip(t, d.Fn(d.Dist, xoroshiro128plus.NewSource(1)), d.Dist, (*ziggurat.Tables).MarshalBinary, func(data []byte, tables *ziggurat.Tables) error { return tables.UnmarshalBinary(data) })
+		})
+	}
+}
+
+func TestInvalidTail(t *testing.T) {
+	cauchy := distuv.StudentsT{Mu: 0.0, Sigma: 1.0, Nu: 1.0}
+	for _, opts := range []ziggurat.Options{{Tail: ziggurat.TailExponential}, {Tail: -1}, {Tail: 100}} {
+		if _, err := ziggurat.NewSymmetricZiggurat(cauchy, nil, opts); !errors.Is(err, ziggurat.ErrInvalidOptions) {
+			t.Errorf("NewSymmetricZiggurat with tail %v returned error %v, want %v", opts.Tail, err, ziggurat.ErrInvalidOptions)
+		}
+	}
+}
+
+func BenchmarkTail(b *testing.B) {
+	for _, d := range TAIL_DISTRIBUTIONS {
+		b.Run(d.Name, func(b *testing.B) {
+			Z := d.Fn(d.Dist, xoroshiro128plus.NewSource(rand.Int64()))
+			for b.Loop() {
+				Z.Rand()
+			}
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package ziggurat
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+)
+
+// Truncate returns distribution restricted to [lo, hi], for lo < hi, with the density scaled to integrate to one.
+// Either bound may be infinite. If the interval excludes the mode, the mode of the result is the bound nearest to it,
+// where the truncated density is monotone.
+func Truncate(distribution Distribution, lo, hi float64) Distribution {
+	return newTruncated(distribution, lo, hi, min(max(distribution.Mode(), lo), hi))
+}
+
+// ToTruncatedZiggurat constructs a Sampler for distribution restricted to [lo, hi]. It panics if the ziggurat cannot be constructed.
+func ToTruncatedZiggurat(distribution Distribution, lo, hi float64, src rand.Source) Sampler {
+	return ToTruncatedZigguratWithOptions(distribution, lo, hi, src, Options{})
+}
+
+// ToTruncatedZigguratWithOptions is like ToTruncatedZiggurat, but configured by opts. It panics if the ziggurat cannot be constructed.
+func ToTruncatedZigguratWithOptions(distribution Distribution, lo, hi float64, src rand.Source, opts Options) Sampler {
+	return must(NewTruncatedZiggurat(distribution, lo, hi, src, opts))
+}
+
+// NewTruncatedZiggurat constructs a Sampler for distribution restricted to [lo, hi], returning an error if the distribution
+// or bounds are invalid. Unlike rejection from the full distribution, the cost of sampling doesn't depend on the probability of [lo, hi].
+func NewTruncatedZiggurat(distribution Distribution, lo, hi float64, src rand.Source, opts Options) (_ Sampler, err error) {
+	defer recoverDistribution(&err)
+	if math.IsNaN(lo) || math.IsNaN(hi) || !(lo < hi) {
+		return nil, fmt.Errorf("%w: [%v, %v]", ErrInvalidBounds, lo, hi)
+	}
+	t := Truncate(distribution, lo, hi).(truncatedDistribution)
+	if math.IsNaN(t.logMass) || math.IsInf(t.logMass, 0) {
+		return nil, fmt.Errorf("%w: log probability %v between %v and %v", ErrInvalidBounds, t.logMass, lo, hi)
+	}
+	return NewZiggurat(t, src, opts)
+}
@@ -0,0 +1,118 @@
+package ziggurat_test
+
+import (
+	"errors"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/argusdusty/ziggurat"
+	"github.com/vpxyz/xorshift/xoroshiro128plus"
+	"gonum.org/v1/gonum/stat/distuv"
+)
+
+const (
+	TRUNCATE_ALPHA   = 0.001
+	TRUNCATE_SAMPLES = 100_000
+)
+
+// The first two moments of the unit normal truncated to [a, b], or the half-normal for a >= 0.
+func truncatedNormalMoment(a, b float64) func(m uint64) float64 {
+	phi := func(x float64) float64 {
+		if math.IsInf(x, 0) {
+			return 0.0
+		}
+		return distuv.UnitNormal.Prob(x)
+	}
+	xphi := func(x float64) float64 {
+		if math.IsInf(x, 0) {
+			return 0.0
+		}
+		return x * distuv.UnitNormal.Prob(x)
+	}
+	// Z is computed from whichever tail is more accurate.
+	Z := distuv.UnitNormal.CDF(b) - distuv.UnitNormal.CDF(a)
+	if a > 0 {
+		Z = (math.Erfc(a/math.Sqrt2) - math.Erfc(b/math.Sqrt2)) / 2
+	}
+	mean := (phi(a) - phi(b)) / Z
+	return func(m uint64) float64 {
+		switch m {
+		case 1:
+			return mean
+		case 2:
+			return 1 + (xphi(a)-xphi(b))/Z
+		}
+		return math.NaN()
+	}
+}
+
+var TRUNCATE_DISTRIBUTIONS = []struct {
+	Name      string
+	Dist      ziggurat.Distribution
+	Lo, Hi    float64
+	Moment    func(m uint64) float64
+	MaxMoment uint64
+}{
+	{Name: "Normal[-1,2]", Dist: distuv.UnitNormal, Lo: -1, Hi: 2, Moment: truncatedNormalMoment(-1, 2), MaxMoment: 1},
+	{Name: "Normal[1,Inf]", Dist: distuv.UnitNormal, Lo: 1, Hi: math.Inf(1), Moment: truncatedNormalMoment(1, math.Inf(1)), MaxMoment: 1},
+	{Name: "Normal[-Inf,-0.5]", Dist: distuv.UnitNormal, Lo: math.Inf(-1), Hi: -0.5, Moment: truncatedNormalMoment(math.Inf(-1), -0.5), MaxMoment: 1},
+	// gonum's normal survival function underflows in the far upper tail, unlike the half-normal's.
+	{Name: "HalfNormal[10,12]", Dist: UnitHalfNormal{}, Lo: 10, Hi: 12, Moment: truncatedNormalMoment(10, 12), MaxMoment: 1},
+	{Name: "HalfNormal[20,Inf]", Dist: UnitHalfNormal{}, Lo: 20, Hi: math.Inf(1), Moment: truncatedNormalMoment(20, math.Inf(1)), MaxMoment: 1},
+	// Where the survival function underflows, the mass is integrated from the density.
+	{Name: "Normal[9,10]", Dist: distuv.UnitNormal, Lo: 9, Hi: 10, Moment: truncatedNormalMoment(9, 10), MaxMoment: 1},
+	{Name: "Normal[-12,-10]", Dist: distuv.UnitNormal, Lo: -12, Hi: -10, Moment: truncatedNormalMoment(-12, -10), MaxMoment: 1},
+	// Where the probability itself underflows, it is integrated relative to the density at the bound, and kept as its log.
+	{Name: "Normal[40,41]", Dist: distuv.UnitNormal, Lo: 40, Hi: 41},
+	{Name: "Normal[-Inf,-40]", Dist: distuv.UnitNormal, Lo: math.Inf(-1), Hi: -40},
+	{Name: "Gamma[0.1,1]", Dist: distuv.Gamma{Alpha: 2.5, Beta: 1.0}, Lo: 0.1, Hi: 1},
+	{Name: "Gamma[1,2]", Dist: distuv.Gamma{Alpha: 2.5, Beta: 1.0}, Lo: 1, Hi: 2},
+	{Name: "Gamma[5,Inf]", Dist: distuv.Gamma{Alpha: 2.5, Beta: 1.0}, Lo: 5, Hi: math.Inf(1)},
+	{Name: "Gamma[30,Inf]", Dist: distuv.Gamma{Alpha: 2.5, Beta: 1.0}, Lo: 30, Hi: math.Inf(1)},
+}
+
+func TestTruncate(t *testing.T) {
+	for _, d := range TRUNCATE_DISTRIBUTIONS {
+		t.Run(d.Name, func(t *testing.T) {
+			testDistributionAllRngs(t, ziggurat.Truncate(d.Dist, d.Lo, d.Hi), d.Moment, d.MaxMoment, TRUNCATE_SAMPLES, TRUNCATE_ALPHA, func(dist ziggurat.Distribution, src rand.Source) ziggurat.Sampler {
+				Z := ziggurat.ToTruncatedZiggurat(d.Dist, d.Lo, d.Hi, src)
+				return boundsChecker{Sampler: Z, t: t, lo: d.Lo, hi: d.Hi}
+			})
+		})
+	}
+}
+
+// Fails the test for any sample outside [lo, hi].
+type boundsChecker struct {
+	ziggurat.Sampler
+	t      *testing.T
+	lo, hi float64
+}
+
+func (b boundsChecker) Rand() float64 {
+	x := b.Sampler.Rand()
+	if !(x >= b.lo && x <= b.hi) {
+		b.t.Fatalf("sample %v outside [%v, %v]", x, b.lo, b.hi)
+	}
+	return x
+}
+
+func TestInvalidBounds(t *testing.T) {
+	for _, bounds := range [][2]float64{{1, 0}, {0, 0}, {math.NaN(), 1}, {0, math.NaN()}, {-2, -1}} {
+		if _, err := ziggurat.NewTruncatedZiggurat(distuv.Gamma{Alpha: 2.5, Beta: 1.0}, bounds[0], bounds[1], nil, ziggurat.Options{}); !errors.Is(err, ziggurat.ErrInvalidBounds) {
+			t.Errorf("NewTruncatedZiggurat with bounds %v returned error %v, want %v", bounds, err, ziggurat.ErrInvalidBounds)
+		}
+	}
+}
+
+func BenchmarkTruncate(b *testing.B) {
+	for _, d := range TRUNCATE_DISTRIBUTIONS {
+		b.Run(d.Name, func(b *testing.B) {
+			Z := ziggurat.ToTruncatedZiggurat(d.Dist, d.Lo, d.Hi, xoroshiro128plus.NewSource(rand.Int64()))
+			for b.Loop() {
+				Z.Rand()
+			}
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package ziggurat
 
 import (
+	"fmt"
+	"iter"
 	"math"
 	"math/rand/v2"
 
@@ -8,23 +10,55 @@ import (
 )
 
 const (
-	ZIGGURAT_BIT_LENGTH = 10 // 10 is the largest feasible number here, otherwise we start to eat into floating point accuracy
-	ZIGGURAT_N          = 1 << ZIGGURAT_BIT_LENGTH
+	ZIGGURAT_BIT_LENGTH = 10                       // 10 is the largest feasible number here, otherwise we start to eat into floating point accuracy
+	ZIGGURAT_N          = 1 << ZIGGURAT_BIT_LENGTH // The default, and maximum, number of strips
+
+	ZIGGURAT32_BIT_LENGTH = ZIGGURAT_BIT_LENGTH + 1    // Single precision splits take half the memory, so twice as many fit in the same space
+	ZIGGURAT32_N          = 1 << ZIGGURAT32_BIT_LENGTH // The default, and maximum, number of strips of a Sampler32
 )
 
+// A Sampler is a random number generator built from a Distribution.
+type Sampler interface {
+	distuv.Rander
+	// Fill fills dst with independent samples from the distribution of Rand.
+	// It may draw from the source in a different order than calling Rand for each element, so it can give different samples.
+	// If the source has a Fill([]uint64) method, as those of the zigsrc package do, the random numbers are drawn from it in batches.
+	Fill(dst []float64)
+	// Samples returns a sequence of n samples, drawn in batches with Fill, so it matches calling Fill if iterated to the end.
+	Samples(n int) iter.Seq[float64]
+	// All returns an unbounded sequence of samples, drawn in batches with Fill.
+	// Stopping the iteration discards the rest of the batch, so the random source may have advanced past the last sample.
+	All() iter.Seq[float64]
+	// WithLocationScale returns a view of the sampler for the distribution of loc + scale*X, sharing its tables and random source.
+	// It panics unless loc is finite and scale is finite and positive.
+	WithLocationScale(loc, scale float64) Sampler
+	// Clone returns a copy of the sampler which shares its tables but draws from src, for use on another goroutine.
+	Clone(src rand.Source) Sampler
+	// Stats reports the expected efficiency of the sampler, and the accuracy of its tables.
+	Stats() Stats
+}
+
 type ziggurat struct {
-	stripSplits     [ZIGGURAT_N]float64
-	stripTops       [ZIGGURAT_N]float64
+	counters        *counters // Counts the branches taken off the fast path, if instrumented.
+	stripSplits     []float64
+	stripTops       []float64
+	mask            uint64 // The number of strips minus one, used to select a strip from the random bits.
+	top             uint64 // The strip of the peak, which the strips above share where their tops overflow.
 	tailPrevSplit   float64
 	hasInfinitePeak bool
 	hasInfiniteTail bool
+	tail            tailEnvelope // Samples the infinite tail, unless its kind is TailQuantile.
+	peak            peakEnvelope // Samples the infinite peak, if its exponent is nonzero.
 	d               Distribution
 	offset          float64
+	scale, shift    float64 // Samples x of the zero-mode distribution are returned as x*scale + shift, with shift including the offset.
+	logDensity      bool    // Whether to compare densities in log space, for a distribution with a log density.
 	src             rand.Source
+	random          *[sampleBatch]uint64 // The random numbers drawn by Fill from a batch source, allocated on its first call.
 }
 
 type flippedZiggurat struct {
-	distuv.Rander
+	Sampler
 	mode float64
 }
 
@@ -34,61 +68,312 @@ type symmetricZiggurat struct {
 
 type twoPartZiggurat struct {
 	rightSideProb float64
-	leftSide      distuv.Rander
-	rightSide     distuv.Rander
+	leftSide      Sampler
+	rightSide     Sampler
 	src           rand.Source
+	batch         *[sampleBatch]float64 // The samples of each side for Fill, allocated on its first call.
+}
+
+// The precision to which the splits of a ziggurat are searched for.
+type precision struct {
+	maxStrips int
+	search    func(fn func(f float64) bool) (float64, error)
+	below     func(x float64) float64 // The next value towards zero.
 }
 
-func toZiggurat(distribution Distribution, src rand.Source) *ziggurat {
+var (
+	double = precision{maxStrips: ZIGGURAT_N, search: searchFloat, below: func(x float64) float64 { return math.Nextafter(x, 0) }}
+	single = precision{maxStrips: ZIGGURAT32_N, search: searchFloat32, below: func(x float64) float64 { return float64(math.Nextafter32(float32(x), 0)) }}
+)
+
+func toZiggurat(distribution Distribution, src rand.Source, opts Options, prec precision) (*ziggurat, error) {
 	if src == nil {
 		src = globalRand{}
 	}
+	n, err := opts.strips(prec.maxStrips)
+	if err != nil {
+		return nil, err
+	}
+	tailKind, err := opts.tail()
+	if err != nil {
+		return nil, err
+	}
 	d := zeroModeDistribution{Distribution: distribution}
+	logDensity := hasLogProb(distribution)
+	prob := func(x float64) float64 { return density(d, logDensity, x) }
+	invalidAt := math.NaN() // A point at which the density is NaN or negative, if any.
 	stripArea := func(x float64) float64 {
 		if math.IsInf(x, 1) {
 			return 0.0
 		}
-		return x*d.Prob(x) + d.Survival(x)
+		p := prob(x)
+		if math.IsNaN(p) || p < 0 {
+			invalidAt = x
+		}
+		return x*p + d.Survival(x)
 	}
-	var z, t [ZIGGURAT_N]float64
-	for i := range ZIGGURAT_N - 1 {
-		z[i] = searchFloat(func(x float64) bool {
-			return stripArea(x) <= float64(i+1)/ZIGGURAT_N
+	prevTailSplit := d.Quantile(1.0)
+	z, t := make([]float64, n), make([]float64, n)
+	for i := range n - 1 {
+		z[i], err = prec.search(func(x float64) bool {
+			return stripArea(x) <= float64(i+1)/float64(n)
 		})
-		t[i] = d.Prob(z[i])
+		if !math.IsNaN(invalidAt) {
+			return nil, fmt.Errorf("%w: density at %v is %v", ErrInvalidDensity, invalidAt, prob(invalidAt))
+		}
+		if err != nil {
+			return nil, err
+		}
+		// The search may overshoot a discontinuous end of the support by rounding.
+		if z[i] > prevTailSplit {
+			z[i] = prevTailSplit
+		}
+		// Where the density is discontinuous, the strip top lies between the density on either side of the split.
+		// Otherwise it is the density at the true split, just below z[i], to the square of the precision.
+		t[i] = prob(z[i])
+		if z[i] > 0 {
+			t[i] = max(t[i], min((float64(i+1)/float64(n)-d.Survival(z[i]))/z[i], prob(prec.below(z[i]))))
+		}
+	}
+	z[n-1] = 0.0
+	t[n-1] = prob(0.0)
+	for i := range n - 1 {
+		if math.IsInf(t[i], 1) && prec.below(z[i]) == 0 {
+			// The splits underflow below the infinite peak, as for Gamma with alpha=1e-3 below 5e-324, where the tops of
+			// strips of equal area overflow. The strips from here on have no width, and share the peak below the last split.
+			for j := i; j < n; j++ {
+				z[j], t[j] = 0.0, math.Inf(1)
+			}
+			break
+		}
+	}
+	if t[0] == 0 && t[n-1] > 0 && math.IsInf(prevTailSplit, 1) {
+		// The density underflows before the survival, as for Student's t with dof=0.01 beyond 2^508, so the base strips
+		// have no density under them, and the mass beyond can't be sampled. Sample the distribution up to where its density ends.
+		end, err := prec.search(func(x float64) bool { return x > 0 && prob(x) == 0 })
+		if err != nil {
+			return nil, err
+		}
+		mode := distribution.Mode()
+		return toZiggurat(newTruncated(distribution, mode, mode+end, mode), src, opts, prec)
+	}
+	if err := validateStrips(d, z, t); err != nil {
+		return nil, err
 	}
-	z[ZIGGURAT_N-1] = 0.0
-	t[ZIGGURAT_N-1] = d.Prob(0.0)
-	prevTailSplit := d.Quantile(1.0)
 	hasInfiniteTail := false
 	if math.IsInf(prevTailSplit, 1) {
-		hasInfiniteTail = true
-		prevTailSplit = z[0] + d.Survival(z[0])/t[0]
+		// Where the density and survival underflow, as for heavy tails, the tail beyond the base strip has no mass left
+		// to sample, and the base strip ends at its split.
+		prevTailSplit = z[0]
+		if survival := d.Survival(z[0]); survival > 0 {
+			hasInfiniteTail = true
+			prevTailSplit = z[0] + survival/t[0]
+		}
+	}
+	if math.IsNaN(prevTailSplit) || math.IsInf(prevTailSplit, 0) || prevTailSplit < z[0] {
+		return nil, fmt.Errorf("%w: tail of the distribution ends at %v, before the base strip at %v", ErrInvalidDensity, prevTailSplit, z[0])
+	}
+	var tail tailEnvelope
+	if hasInfiniteTail {
+		if tail, err = newTailEnvelope(tailKind, d, z[0]); err != nil {
+			return nil, err
+		}
 	}
-	return &ziggurat{stripSplits: z, stripTops: t, tailPrevSplit: prevTailSplit, hasInfinitePeak: math.IsInf(d.Prob(0.0), 1), hasInfiniteTail: hasInfiniteTail, d: d, offset: distribution.Mode(), src: src}
+	hasInfinitePeak := math.IsInf(t[n-1], 1)
+	var peak peakEnvelope
+	if hasInfinitePeak {
+		peak, _ = newPeakEnvelope(d, z, t)
+	}
+	return &ziggurat{stripSplits: z, stripTops: t, mask: uint64(n - 1), top: topStrip(z), tailPrevSplit: prevTailSplit, hasInfinitePeak: hasInfinitePeak, hasInfiniteTail: hasInfiniteTail, tail: tail, peak: peak, d: d, offset: distribution.Mode(), scale: 1.0, shift: distribution.Mode(), logDensity: logDensity, src: src}, nil
 }
 
-func ToZiggurat(distribution Distribution, src rand.Source) distuv.Rander {
+// Check the strips of a zero-mode distribution for consistency. Splits must decrease, with the density and survival increasing.
+func validateStrips(d Distribution, z, t []float64) error {
+	prevSurvival := 0.0
+	for i := range z {
+		if math.IsNaN(z[i]) || math.IsInf(z[i], 0) || z[i] < 0 {
+			return fmt.Errorf("%w: strip %d split at %v", ErrSearchDiverged, i, z[i])
+		}
+		if math.IsNaN(t[i]) || t[i] < 0 || (math.IsInf(t[i], 1) && z[i] > 0) {
+			return fmt.Errorf("%w: strip %d has density %v at %v", ErrInvalidDensity, i, t[i], z[i])
+		}
+		survival := d.Survival(z[i])
+		if math.IsNaN(survival) || survival < prevSurvival || survival > 1 || (i > 0 && z[i] > z[i-1]) {
+			return fmt.Errorf("%w: strip %d has survival %v at %v", ErrNonMonotoneSurvival, i, survival, z[i])
+		}
+		prevSurvival = survival
+		if i > 0 && t[i] < t[i-1] {
+			return fmt.Errorf("%w: strip %d has density %v at %v, below strip %d with density %v at %v", ErrNotUnimodal, i, t[i], z[i], i-1, t[i-1], z[i-1])
+		}
+	}
+	return nil
+}
+
+// The strip of the peak, the first with split 0. It is the last, unless the splits underflowed below an infinite peak.
+func topStrip(z []float64) uint64 {
+	for i := range z {
+		if z[i] == 0 {
+			return uint64(i)
+		}
+	}
+	return uint64(len(z) - 1)
+}
+
+// ToZiggurat constructs a Sampler for distribution. It panics if the ziggurat cannot be constructed.
+func ToZiggurat(distribution Distribution, src rand.Source) Sampler {
+	return ToZigguratWithOptions(distribution, src, Options{})
+}
+
+// ToZigguratWithOptions is like ToZiggurat, but configured by opts. It panics if the ziggurat cannot be constructed.
+func ToZigguratWithOptions(distribution Distribution, src rand.Source, opts Options) Sampler {
+	return must(NewZiggurat(distribution, src, opts))
+}
+
+// NewZiggurat constructs a Sampler for distribution, returning an error if the distribution is invalid.
+func NewZiggurat(distribution Distribution, src rand.Source, opts Options) (_ Sampler, err error) {
+	defer recoverDistribution(&err)
 	if src == nil {
 		src = globalRand{}
 	}
-	if distribution.Survival(distribution.Mode()) == 0.0 {
-		return &flippedZiggurat{Rander: ToZiggurat(flippedDistribution{Distribution: distribution}, src), mode: distribution.Mode()}
+	mode := distribution.Mode()
+	if math.IsNaN(mode) || math.IsInf(mode, 0) {
+		return nil, fmt.Errorf("%w: mode is %v", ErrNotUnimodal, mode)
 	}
-	if distribution.Survival(distribution.Mode()) != 1.0 {
-		return &twoPartZiggurat{rightSideProb: distribution.Survival(distribution.Mode()), leftSide: ToZiggurat(truncatedAboveDistribution{Distribution: distribution}, src), rightSide: ToZiggurat(truncatedBelowDistribution{Distribution: distribution}, src), src: src}
+	modeSurvival := distribution.Survival(mode)
+	if math.IsNaN(modeSurvival) || modeSurvival < 0 || modeSurvival > 1 {
+		return nil, fmt.Errorf("%w: survival at the mode is %v", ErrNonMonotoneSurvival, modeSurvival)
 	}
-	return toZiggurat(distribution, src)
+	if modeSurvival == 0.0 {
+		r, err := NewZiggurat(flippedDistribution{Distribution: distribution}, src, opts)
+		if err != nil {
+			return nil, err
+		}
+		return &flippedZiggurat{Sampler: r, mode: mode}, nil
+	}
+	if modeSurvival != 1.0 {
+		leftSide, err := NewZiggurat(truncatedAbove(distribution), src, opts)
+		if err != nil {
+			return nil, err
+		}
+		rightSide, err := NewZiggurat(truncatedBelow(distribution), src, opts)
+		if err != nil {
+			return nil, err
+		}
+		return &twoPartZiggurat{rightSideProb: modeSurvival, leftSide: leftSide, rightSide: rightSide, src: src}, nil
+	}
+	return toZiggurat(distribution, src, opts, double)
+}
+
+// ToSymmetricZiggurat constructs a Sampler for a distribution symmetric about its mode, which is faster than ToZiggurat.
+// It panics if the ziggurat cannot be constructed.
+func ToSymmetricZiggurat(distribution Distribution, src rand.Source) Sampler {
+	return ToSymmetricZigguratWithOptions(distribution, src, Options{})
+}
+
+// ToSymmetricZigguratWithOptions is like ToSymmetricZiggurat, but configured by opts. It panics if the ziggurat cannot be constructed.
+func ToSymmetricZigguratWithOptions(distribution Distribution, src rand.Source, opts Options) Sampler {
+	return must(NewSymmetricZiggurat(distribution, src, opts))
 }
 
-func ToSymmetricZiggurat(distribution Distribution, src rand.Source) distuv.Rander {
-	return symmetricZiggurat{r: toZiggurat(truncatedBelowDistribution{Distribution: distribution}, src)}
+// NewSymmetricZiggurat constructs a Sampler for a distribution symmetric about its mode, returning an error if the distribution is invalid.
+func NewSymmetricZiggurat(distribution Distribution, src rand.Source, opts Options) (_ Sampler, err error) {
+	defer recoverDistribution(&err)
+	mode := distribution.Mode()
+	if math.IsNaN(mode) || math.IsInf(mode, 0) {
+		return nil, fmt.Errorf("%w: mode is %v", ErrNotUnimodal, mode)
+	}
+	r, err := toZiggurat(truncatedBelow(distribution), src, opts, double)
+	if err != nil {
+		return nil, err
+	}
+	return symmetricZiggurat{r: r}, nil
+}
+
+func must(s Sampler, err error) Sampler {
+	if err != nil {
+		panic(err)
+	}
+	return s
 }
 
 func (z *ziggurat) Rand() float64 {
 	r := z.src.Uint64()
-	index := r & (ZIGGURAT_N - 1)
+	index := r & z.mask
 	x := float64(r>>11) / (1 << 53)
+	// The fast path is repeated here, as in Fill, to keep it apart from the slow path.
+	prevSplit := z.tailPrevSplit
+	if index > 0 {
+		prevSplit = z.stripSplits[index-1]
+	}
+	if y := x * prevSplit; y < z.stripSplits[index] {
+		return y*z.scale + z.shift
+	}
+	return z.sample(index, x)
+}
+
+// A source which draws a batch of random numbers at once, such as those of the zigsrc package.
+type batchSource interface {
+	rand.Source
+	Fill(dst []uint64)
+}
+
+// Draw the random numbers for the first try of each sample in batches from src into *random, allocating it on the first call,
+// then sample with them, drawing from src again as needed.
+func fillBatches[F float32 | float64](random **[sampleBatch]uint64, src batchSource, dst []F, fill func(dst []F, r []uint64)) {
+	if *random == nil {
+		*random = new([sampleBatch]uint64)
+	}
+	for len(dst) > 0 {
+		n := min(len(dst), sampleBatch)
+		src.Fill((*random)[:n])
+		fill(dst[:n], (*random)[:n])
+		dst = dst[n:]
+	}
+}
+
+func (z *ziggurat) Fill(dst []float64) {
+	if src, ok := z.src.(batchSource); ok {
+		fillBatches(&z.random, src, dst, z.fill)
+		return
+	}
+	src, splits, mask, scale, shift := z.src, z.stripSplits, z.mask, z.scale, z.shift
+	for i := range dst {
+		r := src.Uint64()
+		index := r & mask
+		x := float64(r>>11) / (1 << 53)
+		prevSplit := z.tailPrevSplit
+		if index > 0 {
+			prevSplit = splits[index-1]
+		}
+		if y := x * prevSplit; y < splits[index] {
+			dst[i] = y*scale + shift
+			continue
+		}
+		dst[i] = z.sample(index, x)
+	}
+}
+
+// Fill dst, starting each sample from the corresponding random number.
+func (z *ziggurat) fill(dst []float64, random []uint64) {
+	splits, mask, scale, shift := z.stripSplits, z.mask, z.scale, z.shift
+	for i, r := range random {
+		index := r & mask
+		x := float64(r>>11) / (1 << 53)
+		prevSplit := z.tailPrevSplit
+		if index > 0 {
+			prevSplit = splits[index-1]
+		}
+		if y := x * prevSplit; y < splits[index] {
+			dst[i] = y*scale + shift
+			continue
+		}
+		dst[i] = z.sample(index, x)
+	}
+}
+
+// Sample from the strip at index, starting from x uniform in [0, 1).
+func (z *ziggurat) sample(index uint64, x float64) float64 {
+	index = min(index, z.top)
 	for {
 		prevSplit := z.tailPrevSplit
 		if index > 0 {
@@ -96,21 +381,31 @@ func (z *ziggurat) Rand() float64 {
 		}
 		x *= prevSplit
 		if x < z.stripSplits[index] {
-			return x + z.offset
+			return x*z.scale + z.shift
+		}
+		if z.peak.a > 0 && index >= z.peak.strip {
+			z.counters.inc(counterPeak)
+			return z.peak.sample(z.src, z.d, len(z.stripSplits), z.stripSplits[index], prevSplit, z.stripTops[index-1], z.stripTops[index], z.counters)*z.scale + z.shift
 		}
 		stripTop := z.stripTops[index]
 		if index == 0 && z.hasInfiniteTail {
-			return z.d.Quantile(1-(prevSplit-x)*stripTop) + z.offset
+			z.counters.inc(counterTail)
+			if z.tail.kind != TailQuantile {
+				return z.tail.sample(z.src, z.d)*z.scale + z.shift
+			}
+			return z.d.Quantile(1-(prevSplit-x)*stripTop)*z.scale + z.shift
 		}
-		if index == ZIGGURAT_N-1 && z.hasInfinitePeak {
+		if index == z.top && z.hasInfinitePeak {
 			prevTop := 0.0
-			if ZIGGURAT_N > 1 {
-				prevTop = z.stripTops[ZIGGURAT_N-2]
+			if z.top > 0 {
+				prevTop = z.stripTops[z.top-1]
 			}
+			z.counters.inc(counterPeak)
 			for {
-				r := z.d.Quantile((z.d.Survival(0.0) - z.d.Survival(prevSplit)) * rand.New(z.src).Float64())
-				if rand.New(z.src).Float64() > prevTop/z.d.Prob(r) {
-					return r + z.offset
+				z.counters.inc(counterPeakIterations)
+				r := z.d.Quantile((z.d.Survival(0.0) - z.d.Survival(prevSplit)) * uniform(z.src))
+				if acceptPeak(z.src, z.d, z.logDensity, r, prevTop) {
+					return r*z.scale + z.shift
 				}
 			}
 		}
@@ -118,17 +413,91 @@ func (z *ziggurat) Rand() float64 {
 		if index > 0 {
 			stripBottom = z.stripTops[index-1]
 		}
-		if rand.New(z.src).Float64() < (z.d.Prob(x)-stripBottom)/(stripTop-stripBottom) {
-			return x + z.offset
+		if acceptWedge(z.src, z.d, z.logDensity, x, stripBottom, stripTop) {
+			z.counters.inc(counterWedgeAccepts)
+			return x*z.scale + z.shift
 		}
-		x = rand.New(z.src).Float64()
+		z.counters.inc(counterWedgeRejects)
+		x = uniform(z.src)
+	}
+}
+
+// Accept a point x in the wedge of a strip between the densities bottom and top.
+// With logDensity, the comparison is in log space, as the density may under- or overflow where its log doesn't.
+// It includes equality, so a flat wedge, as for a uniform density, always accepts.
+func acceptWedge(src rand.Source, d Distribution, logDensity bool, x, bottom, top float64) bool {
+	y := bottom + uniform(src)*(top-bottom)
+	if logDensity {
+		return math.Log(y) <= logProb(d, x)
+	}
+	return y <= d.Prob(x)
+}
+
+// Accept a point x from the infinite peak, above the density top.
+func acceptPeak(src rand.Source, d Distribution, logDensity bool, x, top float64) bool {
+	if logDensity {
+		return math.Log(uniform(src))+logProb(d, x) > math.Log(top)
 	}
+	return uniform(src)*d.Prob(x) > top
 }
 
 func (z symmetricZiggurat) Rand() float64 {
 	r := z.r.src.Uint64()
-	index := r & (ZIGGURAT_N - 1)
+	index := r & z.r.mask
 	x := float64(int64(r)>>10) / (1 << 53)
+	prevSplit := z.r.tailPrevSplit
+	if index > 0 {
+		prevSplit = z.r.stripSplits[index-1]
+	}
+	if y := x * prevSplit; math.Abs(y) < z.r.stripSplits[index] {
+		return y*z.r.scale + z.r.shift
+	}
+	return z.sample(index, x)
+}
+
+func (z symmetricZiggurat) Fill(dst []float64) {
+	if src, ok := z.r.src.(batchSource); ok {
+		fillBatches(&z.r.random, src, dst, z.fill)
+		return
+	}
+	src, splits, mask, scale, shift := z.r.src, z.r.stripSplits, z.r.mask, z.r.scale, z.r.shift
+	for i := range dst {
+		r := src.Uint64()
+		index := r & mask
+		x := float64(int64(r)>>10) / (1 << 53)
+		prevSplit := z.r.tailPrevSplit
+		if index > 0 {
+			prevSplit = splits[index-1]
+		}
+		if y := x * prevSplit; math.Abs(y) < splits[index] {
+			dst[i] = y*scale + shift
+			continue
+		}
+		dst[i] = z.sample(index, x)
+	}
+}
+
+// Fill dst, starting each sample from the corresponding random number.
+func (z symmetricZiggurat) fill(dst []float64, random []uint64) {
+	splits, mask, scale, shift := z.r.stripSplits, z.r.mask, z.r.scale, z.r.shift
+	for i, r := range random {
+		index := r & mask
+		x := float64(int64(r)>>10) / (1 << 53)
+		prevSplit := z.r.tailPrevSplit
+		if index > 0 {
+			prevSplit = splits[index-1]
+		}
+		if y := x * prevSplit; math.Abs(y) < splits[index] {
+			dst[i] = y*scale + shift
+			continue
+		}
+		dst[i] = z.sample(index, x)
+	}
+}
+
+// Sample from the strip at index, starting from x uniform in [-1, 1).
+func (z symmetricZiggurat) sample(index uint64, x float64) float64 {
+	index = min(index, z.r.top)
 	for {
 		prevSplit := z.r.tailPrevSplit
 		if index > 0 {
@@ -136,24 +505,45 @@ func (z symmetricZiggurat) Rand() float64 {
 		}
 		x *= prevSplit
 		if math.Abs(x) < z.r.stripSplits[index] {
-			return x + z.r.offset
+			return x*z.r.scale + z.r.shift
+		}
+		if z.r.peak.a > 0 && index >= z.r.peak.strip {
+			z.r.counters.inc(counterPeak)
+			r := z.r.peak.sample(z.r.src, z.r.d, len(z.r.stripSplits), z.r.stripSplits[index], prevSplit, z.r.stripTops[index-1], z.r.stripTops[index], z.r.counters)
+			if x < 0 {
+				r = -r
+			}
+			return r*z.r.scale + z.r.shift
 		}
 		stripTop := z.r.stripTops[index]
 		if index == 0 && z.r.hasInfiniteTail {
+			z.r.counters.inc(counterTail)
+			if z.r.tail.kind != TailQuantile {
+				if x < 0 {
+					return -z.r.tail.sample(z.r.src, z.r.d)*z.r.scale + z.r.shift
+				}
+				return z.r.tail.sample(z.r.src, z.r.d)*z.r.scale + z.r.shift
+			}
 			if x < 0 {
-				return -z.r.d.Quantile(1-(prevSplit+x)*stripTop) + z.r.offset
+				return -z.r.d.Quantile(1-(prevSplit+x)*stripTop)*z.r.scale + z.r.shift
 			}
-			return z.r.d.Quantile(1-(prevSplit-x)*stripTop) + z.r.offset
+			return z.r.d.Quantile(1-(prevSplit-x)*stripTop)*z.r.scale + z.r.shift
 		}
-		if index == ZIGGURAT_N-1 && z.r.hasInfinitePeak {
+		if index == z.r.top && z.r.hasInfinitePeak {
+			sign := 1.0
+			if x < 0 {
+				sign = -1.0
+			}
 			prevTop := 0.0
-			if ZIGGURAT_N > 1 {
-				prevTop = z.r.stripTops[ZIGGURAT_N-2]
+			if z.r.top > 0 {
+				prevTop = z.r.stripTops[z.r.top-1]
 			}
+			z.r.counters.inc(counterPeak)
 			for {
-				r := z.r.d.Quantile((z.r.d.Survival(0.0) - z.r.d.Survival(prevSplit)) * rand.New(z.r.src).Float64())
-				if rand.New(z.r.src).Float64() > prevTop/z.r.d.Prob(r) {
-					return r + z.r.offset
+				z.r.counters.inc(counterPeakIterations)
+				r := z.r.d.Quantile((z.r.d.Survival(0.0) - z.r.d.Survival(prevSplit)) * uniform(z.r.src))
+				if acceptPeak(z.r.src, z.r.d, z.r.logDensity, r, prevTop) {
+					return sign*r*z.r.scale + z.r.shift
 				}
 			}
 		}
@@ -161,20 +551,57 @@ func (z symmetricZiggurat) Rand() float64 {
 		if index > 0 {
 			stripBottom = z.r.stripTops[index-1]
 		}
-		if rand.New(z.r.src).Float64() < (z.r.d.Prob(x)-stripBottom)/(stripTop-stripBottom) {
-			return x + z.r.offset
+		if acceptWedge(z.r.src, z.r.d, z.r.logDensity, math.Abs(x), stripBottom, stripTop) {
+			z.r.counters.inc(counterWedgeAccepts)
+			return x*z.r.scale + z.r.shift
 		}
+		z.r.counters.inc(counterWedgeRejects)
 		x = float64(int64(z.r.src.Uint64())>>10) / (1 << 53)
 	}
 }
 
 func (z *flippedZiggurat) Rand() float64 {
-	return 2*z.mode - z.Rander.Rand()
+	return 2*z.mode - z.Sampler.Rand()
+}
+
+func (z *flippedZiggurat) Fill(dst []float64) {
+	z.Sampler.Fill(dst)
+	for i := range dst {
+		dst[i] = 2*z.mode - dst[i]
+	}
 }
 
 func (z *twoPartZiggurat) Rand() float64 {
-	if rand.New(z.src).Float64() < z.rightSideProb {
+	if uniform(z.src) < z.rightSideProb {
 		return z.rightSide.Rand()
 	}
 	return z.leftSide.Rand()
 }
+
+// Fill dst in batches, choosing the side of each sample first, then filling the samples of each side at once.
+func (z *twoPartZiggurat) Fill(dst []float64) {
+	if z.batch == nil {
+		z.batch = new([sampleBatch]float64)
+	}
+	// A sample is on the right side when its 53 uniform bits, as in Rand, are below the threshold.
+	threshold := uint64(math.Ceil(z.rightSideProb * (1 << 53)))
+	var right [sampleBatch]int
+	for len(dst) > 0 {
+		n := min(len(dst), sampleBatch)
+		rights := 0
+		for i := range n {
+			right[i] = int((z.src.Uint64()<<11>>11 - threshold) >> 63)
+			rights += right[i]
+		}
+		z.rightSide.Fill(z.batch[:rights])
+		z.leftSide.Fill(z.batch[rights:n])
+		// Take each sample from the next of its side, without branching on the side.
+		r, l := 0, rights
+		for i := range n {
+			dst[i] = z.batch[l+(r-l)&-right[i]]
+			r += right[i]
+			l += 1 - right[i]
+		}
+		dst = dst[n:]
+	}
+}
@@ -0,0 +1,383 @@
+package ziggurat
+
+import (
+	"fmt"
+	"iter"
+	"math"
+	"math/rand/v2"
+)
+
+// A Sampler32 is a random number generator producing float32, built from a Distribution.
+type Sampler32 interface {
+	Rand() float32
+	// Fill fills dst with independent samples from the distribution of Rand.
+	// It may draw from the source in a different order than calling Rand for each element, so it can give different samples.
+	// If the source has a Fill([]uint64) method, as those of the zigsrc package do, the random numbers are drawn from it in batches.
+	Fill(dst []float32)
+	// Samples returns a sequence of n samples, drawn in batches with Fill, so it matches calling Fill if iterated to the end.
+	Samples(n int) iter.Seq[float32]
+	// All returns an unbounded sequence of samples, drawn in batches with Fill.
+	// Stopping the iteration discards the rest of the batch, so the random source may have advanced past the last sample.
+	All() iter.Seq[float32]
+	// WithLocationScale returns a view of the sampler for the distribution of loc + scale*X, sharing its tables and random source.
+	// It panics unless loc is finite and scale is finite and positive.
+	WithLocationScale(loc, scale float64) Sampler32
+	// Clone returns a copy of the sampler which shares its tables but draws from src, for use on another goroutine.
+	Clone(src rand.Source) Sampler32
+	// Stats reports the expected efficiency of the sampler, and the accuracy of its tables.
+	Stats() Stats
+}
+
+// A ziggurat with single precision splits. Each sample takes 24 bits for x from the top of one Uint64, and the strip index from the bottom.
+// Its tables are mixed precision: the splits, read on the fast path, are float32, while the tops, read only off it, stay
+// float64, as the tops of strips of equal area between float32 splits are rarely float32 themselves, and rounding them
+// would unbalance the areas and bias the wedge tests.
+type ziggurat32 struct {
+	stripSplits     []float32
+	stripTops       []float64 // Double precision, unlike the splits.
+	mask            uint64
+	top             uint64
+	tailPrevSplit   float32
+	hasInfinitePeak bool
+	hasInfiniteTail bool
+	tail            tailEnvelope
+	peak            peakEnvelope
+	d               Distribution
+	scale, shift    float64 // Samples x of the zero-mode distribution are returned as x*scale + shift, with shift including the offset.
+	logDensity      bool
+	src             rand.Source
+	random          *[sampleBatch]uint64 // The random numbers drawn by Fill from a batch source, allocated on its first call.
+}
+
+type symmetricZiggurat32 struct {
+	r *ziggurat32
+}
+
+type twoPartZiggurat32 struct {
+	rightSideProb float64
+	leftSide      Sampler32
+	rightSide     Sampler32
+	src           rand.Source
+	batch         *[sampleBatch]float32 // The samples of each side for Fill, allocated on its first call.
+}
+
+// ToZiggurat32 constructs a Sampler32 for distribution. It panics if the ziggurat cannot be constructed.
+func ToZiggurat32(distribution Distribution, src rand.Source) Sampler32 {
+	return ToZiggurat32WithOptions(distribution, src, Options{})
+}
+
+// ToZiggurat32WithOptions is like ToZiggurat32, but configured by opts. It panics if the ziggurat cannot be constructed.
+func ToZiggurat32WithOptions(distribution Distribution, src rand.Source, opts Options) Sampler32 {
+	return must32(NewZiggurat32(distribution, src, opts))
+}
+
+// NewZiggurat32 constructs a Sampler32 for distribution, returning an error if the distribution is invalid.
+// The splits, which are all the fast path reads, are searched for and stored in single precision, so the fast path touches
+// half the memory of a Sampler's, or as much with the twice as many strips of the default ZIGGURAT32_N.
+func NewZiggurat32(distribution Distribution, src rand.Source, opts Options) (_ Sampler32, err error) {
+	defer recoverDistribution(&err)
+	if src == nil {
+		src = globalRand{}
+	}
+	mode := distribution.Mode()
+	if math.IsNaN(mode) || math.IsInf(mode, 0) {
+		return nil, fmt.Errorf("%w: mode is %v", ErrNotUnimodal, mode)
+	}
+	modeSurvival := distribution.Survival(mode)
+	if math.IsNaN(modeSurvival) || modeSurvival < 0 || modeSurvival > 1 {
+		return nil, fmt.Errorf("%w: survival at the mode is %v", ErrNonMonotoneSurvival, modeSurvival)
+	}
+	if modeSurvival == 0.0 {
+		r, err := toZiggurat32(flippedDistribution{Distribution: distribution}, src, opts)
+		if err != nil {
+			return nil, err
+		}
+		// Flip the samples back about the mode in double precision, with their scale and shift, so each is rounded once.
+		r.scale, r.shift = -r.scale, 2*mode-r.shift
+		return r, nil
+	}
+	if modeSurvival != 1.0 {
+		leftSide, err := NewZiggurat32(truncatedAbove(distribution), src, opts)
+		if err != nil {
+			return nil, err
+		}
+		rightSide, err := NewZiggurat32(truncatedBelow(distribution), src, opts)
+		if err != nil {
+			return nil, err
+		}
+		return &twoPartZiggurat32{rightSideProb: modeSurvival, leftSide: leftSide, rightSide: rightSide, src: src}, nil
+	}
+	return toZiggurat32(distribution, src, opts)
+}
+
+// ToSymmetricZiggurat32 constructs a Sampler32 for a distribution symmetric about its mode, which is faster than ToZiggurat32.
+// It panics if the ziggurat cannot be constructed.
+func ToSymmetricZiggurat32(distribution Distribution, src rand.Source) Sampler32 {
+	return ToSymmetricZiggurat32WithOptions(distribution, src, Options{})
+}
+
+// ToSymmetricZiggurat32WithOptions is like ToSymmetricZiggurat32, but configured by opts. It panics if the ziggurat cannot be constructed.
+func ToSymmetricZiggurat32WithOptions(distribution Distribution, src rand.Source, opts Options) Sampler32 {
+	return must32(NewSymmetricZiggurat32(distribution, src, opts))
+}
+
+// NewSymmetricZiggurat32 constructs a Sampler32 for a distribution symmetric about its mode, returning an error if the distribution is invalid.
+func NewSymmetricZiggurat32(distribution Distribution, src rand.Source, opts Options) (_ Sampler32, err error) {
+	defer recoverDistribution(&err)
+	mode := distribution.Mode()
+	if math.IsNaN(mode) || math.IsInf(mode, 0) {
+		return nil, fmt.Errorf("%w: mode is %v", ErrNotUnimodal, mode)
+	}
+	r, err := toZiggurat32(truncatedBelow(distribution), src, opts)
+	if err != nil {
+		return nil, err
+	}
+	return symmetricZiggurat32{r: r}, nil
+}
+
+func must32(s Sampler32, err error) Sampler32 {
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// Construct the ziggurat with its splits searched for in single precision, so they are stored exactly, except where one is
+// clamped to the end of the support, which is rounded towards zero so the fast path never accepts beyond it.
+// The tops stay in double precision, as rounding them would change the area of each strip by much more than the rounding
+// where the strips are thin, near the mode. A finite base strip is as wide as the support, rounded up to cover it.
+func toZiggurat32(distribution Distribution, src rand.Source, opts Options) (*ziggurat32, error) {
+	z, err := toZiggurat(distribution, src, opts, single)
+	if err != nil {
+		return nil, err
+	}
+	r := &ziggurat32{stripSplits: make([]float32, len(z.stripSplits)), stripTops: z.stripTops, mask: z.mask, top: z.top, hasInfinitePeak: z.hasInfinitePeak, hasInfiniteTail: z.hasInfiniteTail, tail: z.tail, peak: z.peak, d: z.d, scale: z.scale, shift: z.shift, logDensity: z.logDensity, src: z.src}
+	for i, split := range z.stripSplits {
+		r.stripSplits[i] = float32(split)
+		if float64(r.stripSplits[i]) > split {
+			r.stripSplits[i] = math.Nextafter32(r.stripSplits[i], 0)
+		}
+	}
+	r.tailPrevSplit = float32(z.tailPrevSplit)
+	if !z.hasInfiniteTail && float64(r.tailPrevSplit) < z.tailPrevSplit {
+		r.tailPrevSplit = math.Nextafter32(r.tailPrevSplit, float32(math.Inf(1)))
+	}
+	return r, nil
+}
+
+func (z *ziggurat32) Rand() float32 {
+	r := z.src.Uint64()
+	index := r & z.mask
+	x := float32(r>>40) / (1 << 24)
+	prevSplit := z.tailPrevSplit
+	if index > 0 {
+		prevSplit = z.stripSplits[index-1]
+	}
+	if y := x * prevSplit; y < z.stripSplits[index] {
+		return float32(float64(y)*z.scale + z.shift)
+	}
+	return float32(z.sample(index, float64(x))*z.scale + z.shift)
+}
+
+func (z *ziggurat32) Fill(dst []float32) {
+	if src, ok := z.src.(batchSource); ok {
+		fillBatches(&z.random, src, dst, z.fill)
+		return
+	}
+	src, splits, mask, scale, shift := z.src, z.stripSplits, z.mask, z.scale, z.shift
+	for i := range dst {
+		r := src.Uint64()
+		index := r & mask
+		x := float32(r>>40) / (1 << 24)
+		prevSplit := z.tailPrevSplit
+		if index > 0 {
+			prevSplit = splits[index-1]
+		}
+		if y := x * prevSplit; y < splits[index] {
+			dst[i] = float32(float64(y)*scale + shift)
+			continue
+		}
+		dst[i] = float32(z.sample(index, float64(x))*scale + shift)
+	}
+}
+
+// Fill dst, starting each sample from the corresponding random number.
+func (z *ziggurat32) fill(dst []float32, random []uint64) {
+	splits, mask, scale, shift := z.stripSplits, z.mask, z.scale, z.shift
+	for i, r := range random {
+		index := r & mask
+		x := float32(r>>40) / (1 << 24)
+		prevSplit := z.tailPrevSplit
+		if index > 0 {
+			prevSplit = splits[index-1]
+		}
+		if y := x * prevSplit; y < splits[index] {
+			dst[i] = float32(float64(y)*scale + shift)
+			continue
+		}
+		dst[i] = float32(z.sample(index, float64(x))*scale + shift)
+	}
+}
+
+// Sample from the strip at index of the zero-mode distribution, starting from x uniform in [0, 1).
+// The tables are single precision, but the rejection tests are in double precision.
+func (z *ziggurat32) sample(index uint64, x float64) float64 {
+	index = min(index, z.top)
+	for {
+		prevSplit := float64(z.tailPrevSplit)
+		if index > 0 {
+			prevSplit = float64(z.stripSplits[index-1])
+		}
+		x *= prevSplit
+		if x < float64(z.stripSplits[index]) {
+			return x
+		}
+		if z.peak.a > 0 && index >= z.peak.strip {
+			return z.peak.sample(z.src, z.d, len(z.stripSplits), float64(z.stripSplits[index]), prevSplit, z.stripTops[index-1], z.stripTops[index], nil)
+		}
+		stripTop := z.stripTops[index]
+		if index == 0 && z.hasInfiniteTail {
+			if z.tail.kind != TailQuantile {
+				return z.tail.sample(z.src, z.d)
+			}
+			return z.d.Quantile(1 - (prevSplit-x)*stripTop)
+		}
+		if index == z.top && z.hasInfinitePeak {
+			prevTop := 0.0
+			if z.top > 0 {
+				prevTop = z.stripTops[z.top-1]
+			}
+			for {
+				r := z.d.Quantile((z.d.Survival(0.0) - z.d.Survival(prevSplit)) * uniform(z.src))
+				if acceptPeak(z.src, z.d, z.logDensity, r, prevTop) {
+					return r
+				}
+			}
+		}
+		stripBottom := 0.0
+		if index > 0 {
+			stripBottom = z.stripTops[index-1]
+		}
+		if acceptWedge(z.src, z.d, z.logDensity, x, stripBottom, stripTop) {
+			return x
+		}
+		x = uniform(z.src)
+	}
+}
+
+func (z *ziggurat32) Clone(src rand.Source) Sampler32 {
+	if src == nil {
+		src = globalRand{}
+	}
+	v := *z
+	v.src, v.random = src, nil
+	return &v
+}
+
+func (z symmetricZiggurat32) Rand() float32 {
+	r := z.r.src.Uint64()
+	index := r & z.r.mask
+	x := float32(int64(r)>>39) / (1 << 24)
+	prevSplit := z.r.tailPrevSplit
+	if index > 0 {
+		prevSplit = z.r.stripSplits[index-1]
+	}
+	if y := x * prevSplit; y < z.r.stripSplits[index] && -y < z.r.stripSplits[index] {
+		return float32(float64(y)*z.r.scale + z.r.shift)
+	}
+	return z.sample(index, x)
+}
+
+func (z symmetricZiggurat32) Fill(dst []float32) {
+	if src, ok := z.r.src.(batchSource); ok {
+		fillBatches(&z.r.random, src, dst, z.fill)
+		return
+	}
+	src, splits, mask, scale, shift := z.r.src, z.r.stripSplits, z.r.mask, z.r.scale, z.r.shift
+	for i := range dst {
+		r := src.Uint64()
+		index := r & mask
+		x := float32(int64(r)>>39) / (1 << 24)
+		prevSplit := z.r.tailPrevSplit
+		if index > 0 {
+			prevSplit = splits[index-1]
+		}
+		if y := x * prevSplit; y < splits[index] && -y < splits[index] {
+			dst[i] = float32(float64(y)*scale + shift)
+			continue
+		}
+		dst[i] = z.sample(index, x)
+	}
+}
+
+// Fill dst, starting each sample from the corresponding random number.
+func (z symmetricZiggurat32) fill(dst []float32, random []uint64) {
+	splits, mask, scale, shift := z.r.stripSplits, z.r.mask, z.r.scale, z.r.shift
+	for i, r := range random {
+		index := r & mask
+		x := float32(int64(r)>>39) / (1 << 24)
+		prevSplit := z.r.tailPrevSplit
+		if index > 0 {
+			prevSplit = splits[index-1]
+		}
+		if y := x * prevSplit; y < splits[index] && -y < splits[index] {
+			dst[i] = float32(float64(y)*scale + shift)
+			continue
+		}
+		dst[i] = z.sample(index, x)
+	}
+}
+
+// Sample from the strip at index, starting from x uniform in [-1, 1). By symmetry, the sign of x is kept through any rejections.
+func (z symmetricZiggurat32) sample(index uint64, x float32) float32 {
+	if x < 0 {
+		return float32(z.r.shift - z.r.sample(index, float64(-x))*z.r.scale)
+	}
+	return float32(z.r.shift + z.r.sample(index, float64(x))*z.r.scale)
+}
+
+func (z symmetricZiggurat32) Clone(src rand.Source) Sampler32 {
+	return symmetricZiggurat32{r: z.r.Clone(src).(*ziggurat32)}
+}
+
+func (z *twoPartZiggurat32) Rand() float32 {
+	if uniform(z.src) < z.rightSideProb {
+		return z.rightSide.Rand()
+	}
+	return z.leftSide.Rand()
+}
+
+// Fill dst in batches, choosing the side of each sample first, then filling the samples of each side at once.
+func (z *twoPartZiggurat32) Fill(dst []float32) {
+	if z.batch == nil {
+		z.batch = new([sampleBatch]float32)
+	}
+	// A sample is on the right side when its 53 uniform bits, as in Rand, are below the threshold.
+	threshold := uint64(math.Ceil(z.rightSideProb * (1 << 53)))
+	var right [sampleBatch]int
+	for len(dst) > 0 {
+		n := min(len(dst), sampleBatch)
+		rights := 0
+		for i := range n {
+			right[i] = int((z.src.Uint64()<<11>>11 - threshold) >> 63)
+			rights += right[i]
+		}
+		z.rightSide.Fill(z.batch[:rights])
+		z.leftSide.Fill(z.batch[rights:n])
+		// Take each sample from the next of its side, without branching on the side.
+		r, l := 0, rights
+		for i := range n {
+			dst[i] = z.batch[l+(r-l)&-right[i]]
+			r += right[i]
+			l += 1 - right[i]
+		}
+		dst = dst[n:]
+	}
+}
+
+func (z *twoPartZiggurat32) Clone(src rand.Source) Sampler32 {
+	if src == nil {
+		src = globalRand{}
+	}
+	return &twoPartZiggurat32{rightSideProb: z.rightSideProb, leftSide: z.leftSide.Clone(src), rightSide: z.rightSide.Clone(src), src: src}
+}
@@ -0,0 +1,181 @@
+package ziggurat_test
+
+import (
+	"errors"
+	"math"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/argusdusty/ziggurat"
+	"github.com/argusdusty/ziggurat/zigsrc"
+	"github.com/vpxyz/xorshift/xoroshiro128plus"
+	"gonum.org/v1/gonum/stat/distuv"
+)
+
+const (
+	ZIGGURAT32_ALPHA   = 0.001
+	ZIGGURAT32_SAMPLES = 100_000
+)
+
+var ZIGGURAT32_DISTRIBUTIONS = []struct {
+	Name      string
+	Dist      ziggurat.Distribution
+	Fn        func(ziggurat.Distribution, rand.Source) ziggurat.Sampler32
+	Moment    func(m uint64) float64
+	MaxMoment uint64
+}{
+	{Name: "Normal", Dist: distuv.UnitNormal, Fn: ziggurat.ToZiggurat32, Moment: normalMoment, MaxMoment: 4},
+	{Name: "SymmetricNormal", Dist: distuv.UnitNormal, Fn: ziggurat.ToSymmetricZiggurat32, Moment: normalMoment, MaxMoment: 4},
+	{Name: "HalfNormal", Dist: UnitHalfNormal{}, Fn: ziggurat.ToZiggurat32, Moment: halfNormalMoment, MaxMoment: 4},
+	{Name: "NegHalfNormal", Dist: NegUnitHalfNormal{}, Fn: ziggurat.ToZiggurat32, Moment: negHalfNormalMoment, MaxMoment: 4},
+	{Name: "Gamma", Dist: distuv.Gamma{Alpha: 2.5, Beta: 1.0}, Fn: ziggurat.ToZiggurat32, Moment: gammaMoment(2.5, 1), MaxMoment: 4},
+	{Name: "SmallGamma", Dist: distuv.Gamma{Alpha: 0.5, Beta: 1.0}, Fn: ziggurat.ToZiggurat32, Moment: gammaMoment(0.5, 1), MaxMoment: 4},
+	{Name: "SymmetricStudentsT", Dist: distuv.StudentsT{Mu: 0.0, Sigma: 1.0, Nu: 1.0}, Fn: ziggurat.ToSymmetricZiggurat32},
+}
+
+func testFill32(t *testing.T, Z ziggurat.Sampler32, dist ziggurat.Distribution, moment func(m uint64) float64, maxMoment uint64) {
+	var samples32 = make([]float32, ZIGGURAT32_SAMPLES)
+	Z.Fill(samples32)
+	var samples = make([]float64, len(samples32))
+	for i, x := range samples32 {
+		samples[i] = float64(x)
+	}
+	testMoments(t, samples, moment, maxMoment, ZIGGURAT32_ALPHA)
+	testAndersonDarling(t, samples, dist, ZIGGURAT32_ALPHA)
+}
+
+func TestZiggurat32(t *testing.T) {
+	for _, d := range ZIGGURAT32_DISTRIBUTIONS {
+		t.Run(d.Name, func(t *testing.T) {
+			testFill32(t, d.Fn(d.Dist, xoroshiro128plus.NewSource(1)), d.Dist, d.Moment, d.MaxMoment)
+		})
+	}
+}
+
+// Fill must sample the distribution when drawing random numbers from the source in batches.
+func TestFillBatches32(t *testing.T) {
+	for _, d := range ZIGGURAT32_DISTRIBUTIONS {
+		t.Run(d.Name, func(t *testing.T) {
+			testFill32(t, d.Fn(d.Dist, zigsrc.NewXoshiro256PlusPlus(1)), d.Dist, d.Moment, d.MaxMoment)
+		})
+	}
+}
+
+// A Sampler32 allows twice as many strips as a Sampler, in the same memory for the fast path.
+func TestStrips32(t *testing.T) {
+	opts := ziggurat.Options{Strips: ziggurat.ZIGGURAT32_N}
+	if _, err := ziggurat.NewZiggurat(distuv.UnitNormal, nil, opts); !errors.Is(err, ziggurat.ErrInvalidOptions) {
+		t.Errorf("NewZiggurat with %d strips returned error %v, expected %v", opts.Strips, err, ziggurat.ErrInvalidOptions)
+	}
+	testFill32(t, ziggurat.ToSymmetricZiggurat32WithOptions(distuv.UnitNormal, xoroshiro128plus.NewSource(1), opts), distuv.UnitNormal, normalMoment, 4)
+	for _, strips := range []int{1, 3, 2 * ziggurat.ZIGGURAT32_N} {
+		if _, err := ziggurat.NewZiggurat32(distuv.UnitNormal, nil, ziggurat.Options{Strips: strips}); !errors.Is(err, ziggurat.ErrInvalidOptions) {
+			t.Errorf("NewZiggurat32 with %d strips returned error %v, expected %v", strips, err, ziggurat.ErrInvalidOptions)
+		}
+	}
+}
+
+// TestZiggurat32 checks the samples of Fill, which may differ from those of Rand, so Rand must sample the distribution too.
+func TestRand32(t *testing.T) {
+	for _, d := range ZIGGURAT32_DISTRIBUTIONS {
+		t.Run(d.Name, func(t *testing.T) {
+			Z := d.Fn(d.Dist, xoroshiro128plus.NewSource(2)).Clone(xoroshiro128plus.NewSource(1))
+			var samples = make([]float64, ZIGGURAT32_SAMPLES)
+			for i := range samples {
+				samples[i] = float64(Z.Rand())
+			}
+			testMoments(t, samples, d.Moment, d.MaxMoment, ZIGGURAT32_ALPHA)
+			testAndersonDarling(t, samples, d.Dist, ZIGGURAT32_ALPHA)
+		})
+	}
+}
+
+// Each position of Fill must sample the distribution, so composite samplers must interleave the samples of their parts.
+func TestFillPositions32(t *testing.T) {
+	for _, d := range ZIGGURAT32_DISTRIBUTIONS {
+		t.Run(d.Name, func(t *testing.T) {
+			Z := d.Fn(d.Dist, xoroshiro128plus.NewSource(1))
+			var dst32 = make([]float32, FILL_POSITION_LEN)
+			fill := func(dst []float64) {
+				Z.Fill(dst32)
+				for i, x := range dst32 {
+					dst[i] = float64(x)
+				}
+			}
+			for _, i := range []int{0, FILL_POSITION_LEN - 1} {
+				testAndersonDarling(t, positionSamples(fill, FILL_POSITION_LEN, i), d.Dist, FILL_POSITION_ALPHA)
+			}
+		})
+	}
+}
+
+// Samples and All must produce exactly the same samples as calls to Fill of the same lengths.
+func TestSamples32(t *testing.T) {
+	for _, d := range ZIGGURAT32_DISTRIBUTIONS {
+		t.Run(d.Name, func(t *testing.T) {
+			// An odd length, so the last batch is partial. The rest is a multiple of the batch size, so All's batches match Fill's.
+			const N = ZIGGURAT32_SAMPLES/2 + 1
+			const M = 4096
+			Z := d.Fn(d.Dist, xoroshiro128plus.NewSource(1))
+			var want = make([]float32, N+M)
+			Z.Fill(want[:N])
+			Z.Fill(want[N:])
+			Z = d.Fn(d.Dist, xoroshiro128plus.NewSource(1))
+			got := slices.Collect(Z.Samples(N))
+			if len(got) != N {
+				t.Fatalf("Samples(%d) yielded %d samples", N, len(got))
+			}
+			for x := range Z.All() {
+				got = append(got, x)
+				if len(got) == len(want) {
+					break
+				}
+			}
+			for i := range got {
+				if got[i] != want[i] {
+					t.Fatalf("Iterated sample %d = %v, Fill sample = %v", i, got[i], want[i])
+				}
+			}
+		})
+	}
+}
+
+// A view must produce the transformed samples of the original, up to single precision rounding.
+func TestLocationScaleView32(t *testing.T) {
+	for _, d := range ZIGGURAT32_DISTRIBUTIONS {
+		t.Run(d.Name, func(t *testing.T) {
+			Z := d.Fn(d.Dist, xoroshiro128plus.NewSource(1))
+			V := d.Fn(d.Dist, xoroshiro128plus.NewSource(1)).WithLocationScale(1, 2).WithLocationScale(3, 0.25)
+			for i := range ZIGGURAT32_SAMPLES {
+				if want, got := 3.25+0.5*float64(Z.Rand()), float64(V.Rand()); math.Abs(got-want) > 1e-6*math.Max(1, math.Abs(want)) {
+					t.Fatalf("View sample %d = %v, want %v", i, got, want)
+				}
+			}
+		})
+	}
+}
+
+// Single precision splits must keep the strips at equal areas, as in double precision.
+func TestStats32(t *testing.T) {
+	for _, d := range ZIGGURAT32_DISTRIBUTIONS {
+		t.Run(d.Name, func(t *testing.T) {
+			if stats := d.Fn(d.Dist, nil).Stats(); stats.MaxStripAreaError > 1e-9 {
+				t.Errorf("MaxStripAreaError = %v", stats.MaxStripAreaError)
+			}
+		})
+	}
+}
+
+func BenchmarkZiggurat32(b *testing.B) {
+	for _, d := range ZIGGURAT32_DISTRIBUTIONS {
+		b.Run(d.Name, func(b *testing.B) {
+			Z := d.Fn(d.Dist, xoroshiro128plus.NewSource(rand.Int64()))
+			var dst = make([]float32, 1024)
+			for b.Loop() {
+				Z.Fill(dst)
+			}
+			b.ReportMetric(float64(b.Elapsed().Nanoseconds())/float64(b.N*len(dst)), "ns/sample")
+		})
+	}
+}
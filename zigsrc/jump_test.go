@@ -0,0 +1,80 @@
+package zigsrc
+
+import (
+	"slices"
+	"testing"
+)
+
+// A linear map on states of n words over GF(2), as the images of the basis vectors.
+type linearMap [][]uint64
+
+// The linear map of a generator's transition, from applying it to each basis vector.
+func transition(n int, next func(state []uint64)) linearMap {
+	m := make(linearMap, 64*n)
+	for j := range m {
+		m[j] = make([]uint64, n)
+		m[j][j/64] = 1 << (j % 64)
+		next(m[j])
+	}
+	return m
+}
+
+func (m linearMap) apply(v []uint64) []uint64 {
+	r := make([]uint64, len(v))
+	for j := range m {
+		if v[j/64]&(1<<(j%64)) != 0 {
+			for i := range r {
+				r[i] ^= m[j][i]
+			}
+		}
+	}
+	return r
+}
+
+// The composition m after o.
+func (m linearMap) after(o linearMap) linearMap {
+	r := make(linearMap, len(o))
+	for j := range o {
+		r[j] = m.apply(o[j])
+	}
+	return r
+}
+
+// The jump polynomials must advance the state by their stated powers of two, checked by repeatedly squaring the transition.
+func TestJumpPolynomials(t *testing.T) {
+	x128 := func(s []uint64) {
+		x := Xoroshiro128Plus{s: [2]uint64(s)}
+		x.Uint64()
+		copy(s, x.s[:])
+	}
+	x256 := func(s []uint64) {
+		x := Xoshiro256PlusPlus{s: [4]uint64(s)}
+		x.Uint64()
+		copy(s, x.s[:])
+	}
+	for _, test := range []struct {
+		Name  string
+		Words int
+		Next  func(s []uint64)
+		Log2  int
+		Jump  func(s []uint64)
+	}{
+		{"Xoroshiro128Plus.Jump", 2, x128, 64, func(s []uint64) { x := Xoroshiro128Plus{s: [2]uint64(s)}; x.Jump(); copy(s, x.s[:]) }},
+		{"Xoroshiro128Plus.LongJump", 2, x128, 96, func(s []uint64) { x := Xoroshiro128Plus{s: [2]uint64(s)}; x.LongJump(); copy(s, x.s[:]) }},
+		{"Xoshiro256PlusPlus.Jump", 4, x256, 128, func(s []uint64) { x := Xoshiro256PlusPlus{s: [4]uint64(s)}; x.Jump(); copy(s, x.s[:]) }},
+		{"Xoshiro256PlusPlus.LongJump", 4, x256, 192, func(s []uint64) { x := Xoshiro256PlusPlus{s: [4]uint64(s)}; x.LongJump(); copy(s, x.s[:]) }},
+	} {
+		t.Run(test.Name, func(t *testing.T) {
+			power := transition(test.Words, test.Next)
+			for range test.Log2 {
+				power = power.after(power)
+			}
+			state := NewXoshiro256PlusPlus(1).s[:test.Words]
+			want := power.apply(state)
+			test.Jump(state)
+			if !slices.Equal(state, want) {
+				t.Errorf("Jumped state %#x, want %#x", state, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package zigsrc
+
+// PCGDXSM is the 128-bit PCG generator of O'Neill (2014) with the DXSM output function, with period 2^128.
+// It produces the same numbers as math/rand/v2's PCG from the same state, and is recommended for 128-bit PCG by NumPy.
+type PCGDXSM struct {
+	hi, lo uint64
+}
+
+const (
+	pcgMulHi = 2549297995355413924
+	pcgMulLo = 4865540595714422341
+	pcgIncHi = 6364136223846793005
+	pcgIncLo = 1442695040888963407
+)
+
+// NewPCGDXSM returns a PCG-DXSM source, with its state from the first outputs of SplitMix64 seeded with seed.
+// This is the same as rand.NewPCG(hi, lo), where hi and lo are those outputs.
+func NewPCGDXSM(seed uint64) *PCGDXSM {
+	sm := NewSplitMix64(seed)
+	return &PCGDXSM{hi: sm.Uint64(), lo: sm.Uint64()}
+}
+
+// The DXSM output of the state, which is the next state of the LCG.
+func dxsm(hi, lo uint64) uint64 {
+	const cheapMul = 0xda942042e4dd58b5
+	hi ^= hi >> 32
+	hi *= cheapMul
+	hi ^= hi >> 48
+	return hi * (lo | 1)
+}
+
+// The next state of the LCG, state*mul + inc.
+func lcg(hi, lo uint64) (uint64, uint64) {
+	hi, lo = mul128(hi, lo, pcgMulHi, pcgMulLo)
+	return add128(hi, lo, pcgIncHi, pcgIncLo)
+}
+
+func (p *PCGDXSM) Uint64() uint64 {
+	p.hi, p.lo = lcg(p.hi, p.lo)
+	return dxsm(p.hi, p.lo)
+}
+
+// Fill fills dst with random numbers, equivalent to calling Uint64 for each element.
+func (p *PCGDXSM) Fill(dst []uint64) {
+	hi, lo := p.hi, p.lo
+	for i := range dst {
+		hi, lo = lcg(hi, lo)
+		dst[i] = dxsm(hi, lo)
+	}
+	p.hi, p.lo = hi, lo
+}
+
+// Advance skips the next delta random numbers.
+func (p *PCGDXSM) Advance(delta uint64) {
+	p.advance(0, delta)
+}
+
+// Jump advances the source by 2^64 random numbers. Jumping a copy repeatedly gives 2^64 non-overlapping streams.
+func (p *PCGDXSM) Jump() {
+	p.advance(1, 0)
+}
+
+// Advance the LCG by a 128-bit delta in logarithmic time, by composing its affine steps, as in Brown (1994).
+func (p *PCGDXSM) advance(deltaHi, deltaLo uint64) {
+	accMulHi, accMulLo, accIncHi, accIncLo := uint64(0), uint64(1), uint64(0), uint64(0)
+	mulHi, mulLo, incHi, incLo := uint64(pcgMulHi), uint64(pcgMulLo), uint64(pcgIncHi), uint64(pcgIncLo)
+	for deltaHi != 0 || deltaLo != 0 {
+		if deltaLo&1 != 0 {
+			accMulHi, accMulLo = mul128(accMulHi, accMulLo, mulHi, mulLo)
+			accIncHi, accIncLo = mul128(accIncHi, accIncLo, mulHi, mulLo)
+			accIncHi, accIncLo = add128(accIncHi, accIncLo, incHi, incLo)
+		}
+		// The step applied twice is x*mul^2 + (mul+1)*inc.
+		mulPlusOneHi, mulPlusOneLo := add128(mulHi, mulLo, 0, 1)
+		incHi, incLo = mul128(mulPlusOneHi, mulPlusOneLo, incHi, incLo)
+		mulHi, mulLo = mul128(mulHi, mulLo, mulHi, mulLo)
+		deltaLo = deltaLo>>1 | deltaHi<<63
+		deltaHi >>= 1
+	}
+	hi, lo := mul128(p.hi, p.lo, accMulHi, accMulLo)
+	p.hi, p.lo = add128(hi, lo, accIncHi, accIncLo)
+}
+
+// Split returns a new source seeded from the next outputs of s.
+// Its stream overlaps that of s, or of any other split, with negligible probability.
+func (p *PCGDXSM) Split() *PCGDXSM {
+	return &PCGDXSM{hi: p.Uint64(), lo: p.Uint64()}
+}
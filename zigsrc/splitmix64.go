@@ -0,0 +1,53 @@
+package zigsrc
+
+import "math/bits"
+
+// SplitMix64 is the generator of Steele, Lea and Flood (2014), with 64 bits of state and period 2^64.
+// It is the fastest of the sources, and passes BigCrush, but its short period makes it best suited to seeding others.
+type SplitMix64 struct {
+	state, gamma uint64
+}
+
+// NewSplitMix64 returns a SplitMix64 source starting from seed, with the default gamma as in java.util.SplittableRandom.
+func NewSplitMix64(seed uint64) *SplitMix64 {
+	return &SplitMix64{state: seed, gamma: golden}
+}
+
+func (s *SplitMix64) Uint64() uint64 {
+	s.state += s.gamma
+	return mix64(s.state)
+}
+
+// Fill fills dst with random numbers, equivalent to calling Uint64 for each element.
+func (s *SplitMix64) Fill(dst []uint64) {
+	state, gamma := s.state, s.gamma
+	for i := range dst {
+		state += gamma
+		dst[i] = mix64(state)
+	}
+	s.state = state
+}
+
+// Advance skips the next delta random numbers.
+func (s *SplitMix64) Advance(delta uint64) {
+	s.state += delta * s.gamma
+}
+
+// Split returns a new source with its own seed and gamma drawn from s, as in java.util.SplittableRandom.
+// Its stream is distinct from that of s, and of any other split, with high probability.
+func (s *SplitMix64) Split() *SplitMix64 {
+	seed := s.Uint64()
+	s.state += s.gamma
+	return &SplitMix64{state: seed, gamma: mixGamma(s.state)}
+}
+
+// An odd gamma from z, by MurmurHash3's mixer, with enough bit transitions to scramble the state.
+func mixGamma(z uint64) uint64 {
+	z = (z ^ (z >> 33)) * 0xff51afd7ed558ccd
+	z = (z ^ (z >> 33)) * 0xc4ceb9fe1a85ec53
+	z = (z ^ (z >> 33)) | 1
+	if bits.OnesCount64(z^(z>>1)) < 24 {
+		z ^= 0xaaaaaaaaaaaaaaaa
+	}
+	return z
+}
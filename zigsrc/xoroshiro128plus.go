@@ -0,0 +1,52 @@
+package zigsrc
+
+import "math/bits"
+
+// Xoroshiro128Plus is the xoroshiro128+ generator of Blackman and Vigna (2018), with 128 bits of state and period 2^128-1.
+// Its lowest bits are weak, but the samplers use only the highest 53 bits for the sample, and the lowest for the strip.
+type Xoroshiro128Plus struct {
+	s [2]uint64
+}
+
+// NewXoroshiro128Plus returns a xoroshiro128+ source, with its state from the first outputs of SplitMix64 seeded with seed.
+func NewXoroshiro128Plus(seed uint64) *Xoroshiro128Plus {
+	sm := NewSplitMix64(seed)
+	return &Xoroshiro128Plus{s: [2]uint64{sm.Uint64(), sm.Uint64()}}
+}
+
+func (x *Xoroshiro128Plus) Uint64() uint64 {
+	s0, s1 := x.s[0], x.s[1]
+	r := s0 + s1
+	s1 ^= s0
+	x.s[0] = bits.RotateLeft64(s0, 24) ^ s1 ^ (s1 << 16)
+	x.s[1] = bits.RotateLeft64(s1, 37)
+	return r
+}
+
+// Fill fills dst with random numbers, equivalent to calling Uint64 for each element.
+func (x *Xoroshiro128Plus) Fill(dst []uint64) {
+	s0, s1 := x.s[0], x.s[1]
+	for i := range dst {
+		dst[i] = s0 + s1
+		s1 ^= s0
+		s0 = bits.RotateLeft64(s0, 24) ^ s1 ^ (s1 << 16)
+		s1 = bits.RotateLeft64(s1, 37)
+	}
+	x.s[0], x.s[1] = s0, s1
+}
+
+// Jump advances the source by 2^64 random numbers. Jumping a copy repeatedly gives 2^64 non-overlapping streams.
+func (x *Xoroshiro128Plus) Jump() {
+	jump(x.s[:], []uint64{0xdf900294d8f554a5, 0x170865df4b3201fc}, func() { x.Uint64() })
+}
+
+// LongJump advances the source by 2^96 random numbers, for 2^32 starting points, each of which can be split further by Jump.
+func (x *Xoroshiro128Plus) LongJump() {
+	jump(x.s[:], []uint64{0xd2a98b26625eee7b, 0xdddf9b1090aa7ac1}, func() { x.Uint64() })
+}
+
+// Split returns a new source seeded from the next output of s.
+// Its stream overlaps that of s, or of any other split, with negligible probability.
+func (x *Xoroshiro128Plus) Split() *Xoroshiro128Plus {
+	return NewXoroshiro128Plus(x.Uint64())
+}
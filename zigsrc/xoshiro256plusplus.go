@@ -0,0 +1,60 @@
+package zigsrc
+
+import "math/bits"
+
+// Xoshiro256PlusPlus is the xoshiro256++ generator of Blackman and Vigna (2018), with 256 bits of state and period 2^256-1.
+// It is the recommended all-purpose generator of its authors, with no known weak bits.
+type Xoshiro256PlusPlus struct {
+	s [4]uint64
+}
+
+// NewXoshiro256PlusPlus returns a xoshiro256++ source, with its state from the first outputs of SplitMix64 seeded with seed.
+func NewXoshiro256PlusPlus(seed uint64) *Xoshiro256PlusPlus {
+	sm := NewSplitMix64(seed)
+	return &Xoshiro256PlusPlus{s: [4]uint64{sm.Uint64(), sm.Uint64(), sm.Uint64(), sm.Uint64()}}
+}
+
+func (x *Xoshiro256PlusPlus) Uint64() uint64 {
+	s0, s1, s2, s3 := x.s[0], x.s[1], x.s[2], x.s[3]
+	r := bits.RotateLeft64(s0+s3, 23) + s0
+	t := s1 << 17
+	s2 ^= s0
+	s3 ^= s1
+	s1 ^= s2
+	s0 ^= s3
+	s2 ^= t
+	x.s[0], x.s[1], x.s[2], x.s[3] = s0, s1, s2, bits.RotateLeft64(s3, 45)
+	return r
+}
+
+// Fill fills dst with random numbers, equivalent to calling Uint64 for each element.
+func (x *Xoshiro256PlusPlus) Fill(dst []uint64) {
+	s0, s1, s2, s3 := x.s[0], x.s[1], x.s[2], x.s[3]
+	for i := range dst {
+		dst[i] = bits.RotateLeft64(s0+s3, 23) + s0
+		t := s1 << 17
+		s2 ^= s0
+		s3 ^= s1
+		s1 ^= s2
+		s0 ^= s3
+		s2 ^= t
+		s3 = bits.RotateLeft64(s3, 45)
+	}
+	x.s[0], x.s[1], x.s[2], x.s[3] = s0, s1, s2, s3
+}
+
+// Jump advances the source by 2^128 random numbers. Jumping a copy repeatedly gives 2^128 non-overlapping streams.
+func (x *Xoshiro256PlusPlus) Jump() {
+	jump(x.s[:], []uint64{0x180ec6d33cfd0aba, 0xd5a61266f0c9392c, 0xa9582618e03fc9aa, 0x39abdc4529b1661c}, func() { x.Uint64() })
+}
+
+// LongJump advances the source by 2^192 random numbers, for 2^64 starting points, each of which can be split further by Jump.
+func (x *Xoshiro256PlusPlus) LongJump() {
+	jump(x.s[:], []uint64{0x76e15d3efefdcbbf, 0xc5004e441c522fb3, 0x77710069854ee241, 0x39109bb02acbe635}, func() { x.Uint64() })
+}
+
+// Split returns a new source seeded from the next output of s.
+// Its stream overlaps that of s, or of any other split, with negligible probability.
+func (x *Xoshiro256PlusPlus) Split() *Xoshiro256PlusPlus {
+	return NewXoshiro256PlusPlus(x.Uint64())
+}
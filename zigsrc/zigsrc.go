@@ -0,0 +1,57 @@
+// Package zigsrc implements fast random sources for the ziggurat samplers.
+//
+// Each source implements rand.Source, and a Fill method which draws a batch of random numbers at once. A sampler's Fill
+// draws from a source's Fill in batches, avoiding an interface call for each sample.
+// Each source is seeded from the outputs of SplitMix64, and has jump or split methods for drawing parallel streams:
+//
+//	src := zigsrc.NewXoshiro256PlusPlus(42)
+//	for i := range workers {
+//		streams[i] = *src // Each stream has 2^128 numbers before overlapping the next.
+//		src.Jump()
+//	}
+//
+// None of the sources are safe for concurrent use.
+package zigsrc
+
+import "math/bits"
+
+// The increment of SplitMix64, and the default gamma of a SplitMix64 source, 2^64 divided by the golden ratio.
+const golden = 0x9e3779b97f4a7c15
+
+// The output function of SplitMix64, variant 13 of Stafford's mixers.
+func mix64(z uint64) uint64 {
+	z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+	z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+	return z ^ (z >> 31)
+}
+
+// Apply the jump polynomial of a linear generator with the given state, by summing the states after each power of
+// the transition in the polynomial.
+func jump(state []uint64, poly []uint64, next func()) {
+	acc := make([]uint64, len(state))
+	for _, p := range poly {
+		for b := range 64 {
+			if p&(1<<b) != 0 {
+				for i := range acc {
+					acc[i] ^= state[i]
+				}
+			}
+			next()
+		}
+	}
+	copy(state, acc)
+}
+
+// The 128-bit product a*b, truncated to 128 bits.
+func mul128(aHi, aLo, bHi, bLo uint64) (hi, lo uint64) {
+	hi, lo = bits.Mul64(aLo, bLo)
+	hi += aHi*bLo + aLo*bHi
+	return hi, lo
+}
+
+// The 128-bit sum a+b, truncated to 128 bits.
+func add128(aHi, aLo, bHi, bLo uint64) (hi, lo uint64) {
+	lo, c := bits.Add64(aLo, bLo, 0)
+	hi, _ = bits.Add64(aHi, bHi, c)
+	return hi, lo
+}
@@ -0,0 +1,142 @@
+package zigsrc_test
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"github.com/argusdusty/ziggurat/zigsrc"
+	"github.com/vpxyz/xorshift/splitmix64"
+	"github.com/vpxyz/xorshift/xoroshiro256plusplus"
+)
+
+const SOURCE_SAMPLES = 1000
+
+type source interface {
+	rand.Source
+	Fill(dst []uint64)
+}
+
+var SOURCES = []struct {
+	Name string
+	New  func(seed uint64) source
+	// An independent implementation seeded the same way, if there is one.
+	Reference func(seed uint64) rand.Source
+}{
+	{"SplitMix64", func(seed uint64) source { return zigsrc.NewSplitMix64(seed) }, func(seed uint64) rand.Source { return splitmix64.NewSource(int64(seed)) }},
+	{"Xoroshiro128Plus", func(seed uint64) source { return zigsrc.NewXoroshiro128Plus(seed) }, nil},
+	{"Xoshiro256PlusPlus", func(seed uint64) source { return zigsrc.NewXoshiro256PlusPlus(seed) }, func(seed uint64) rand.Source { return xoroshiro256plusplus.NewSource(int64(seed)) }},
+	{"PCGDXSM", func(seed uint64) source { return zigsrc.NewPCGDXSM(seed) }, func(seed uint64) rand.Source {
+		sm := zigsrc.NewSplitMix64(seed)
+		return rand.NewPCG(sm.Uint64(), sm.Uint64())
+	}},
+}
+
+// Each source must match its reference, and Fill must match repeated calls to Uint64.
+func TestSources(t *testing.T) {
+	for _, s := range SOURCES {
+		t.Run(s.Name, func(t *testing.T) {
+			for _, seed := range []uint64{0, 1, 42, 1 << 63} {
+				src := s.New(seed)
+				want := make([]uint64, SOURCE_SAMPLES)
+				for i := range want {
+					want[i] = src.Uint64()
+				}
+				if s.Reference != nil {
+					ref := s.Reference(seed)
+					for i := range want {
+						if got := ref.Uint64(); got != want[i] {
+							t.Fatalf("Seed %d output %d = %#x, reference %#x", seed, i, want[i], got)
+						}
+					}
+				}
+				src = s.New(seed)
+				got := make([]uint64, SOURCE_SAMPLES)
+				// Fill in uneven pieces, to check the state carries between them.
+				src.Fill(got[:1])
+				src.Fill(got[1:100])
+				src.Fill(got[100:])
+				for i := range got {
+					if got[i] != want[i] {
+						t.Fatalf("Seed %d Fill output %d = %#x, Uint64 output %#x", seed, i, got[i], want[i])
+					}
+				}
+			}
+		})
+	}
+}
+
+// Xoroshiro128Plus has no independent Go implementation, so check its first outputs against the reference C code,
+// xoroshiro128plus.c with its state from splitmix64.c seeded with 42.
+func TestXoroshiro128PlusKnownAnswer(t *testing.T) {
+	want := []uint64{
+		0xe6c71559e2525f98, 0x13b69ac93ec06b57, 0x879006cb74f40d36, 0x52d5476ee695f446,
+		0x78795b7b60107e04, 0xf55dc2d92759148d, 0xd6989b905c553e57, 0x950ea49b74472750,
+	}
+	src := zigsrc.NewXoroshiro128Plus(42)
+	for i := range want {
+		if got := src.Uint64(); got != want[i] {
+			t.Errorf("Output %d = %#x, reference %#x", i, got, want[i])
+		}
+	}
+}
+
+// Advance must skip exactly delta outputs, and Jump must be 2^64 of them.
+func TestAdvance(t *testing.T) {
+	for _, s := range []struct {
+		Name    string
+		Src     func() rand.Source
+		Advance func(src rand.Source, delta uint64)
+	}{
+		{"SplitMix64", func() rand.Source { return zigsrc.NewSplitMix64(1) }, func(src rand.Source, delta uint64) { src.(*zigsrc.SplitMix64).Advance(delta) }},
+		{"PCGDXSM", func() rand.Source { return zigsrc.NewPCGDXSM(1) }, func(src rand.Source, delta uint64) { src.(*zigsrc.PCGDXSM).Advance(delta) }},
+	} {
+		t.Run(s.Name, func(t *testing.T) {
+			for _, delta := range []uint64{0, 1, 2, 3, 100, 1023} {
+				stepped, advanced := s.Src(), s.Src()
+				for range delta {
+					stepped.Uint64()
+				}
+				s.Advance(advanced, delta)
+				if want, got := stepped.Uint64(), advanced.Uint64(); got != want {
+					t.Errorf("Advance(%d) output %#x, want %#x", delta, got, want)
+				}
+			}
+		})
+	}
+	jumped, advanced := zigsrc.NewPCGDXSM(1), zigsrc.NewPCGDXSM(1)
+	jumped.Jump()
+	advanced.Advance(1 << 63)
+	advanced.Advance(1 << 63)
+	if want, got := advanced.Uint64(), jumped.Uint64(); got != want {
+		t.Errorf("PCGDXSM Jump output %#x, want %#x", got, want)
+	}
+}
+
+// Jumped and split streams must differ from the original, and from each other.
+func TestStreams(t *testing.T) {
+	x128, x256, pcg, sm := zigsrc.NewXoroshiro128Plus(1), zigsrc.NewXoshiro256PlusPlus(1), zigsrc.NewPCGDXSM(1), zigsrc.NewSplitMix64(1)
+	streams := map[string]rand.Source{"Xoroshiro128Plus": x128, "Xoshiro256PlusPlus": x256, "PCGDXSM": pcg, "SplitMix64": sm}
+	for name, src := range map[string]rand.Source{
+		"Xoroshiro128Plus.Split":      x128.Split(),
+		"Xoroshiro128Plus.Jump":       func() rand.Source { c := *x128; c.Jump(); return &c }(),
+		"Xoroshiro128Plus.LongJump":   func() rand.Source { c := *x128; c.LongJump(); return &c }(),
+		"Xoshiro256PlusPlus.Split":    x256.Split(),
+		"Xoshiro256PlusPlus.Jump":     func() rand.Source { c := *x256; c.Jump(); return &c }(),
+		"Xoshiro256PlusPlus.LongJump": func() rand.Source { c := *x256; c.LongJump(); return &c }(),
+		"PCGDXSM.Split":               pcg.Split(),
+		"PCGDXSM.Jump":                func() rand.Source { c := *pcg; c.Jump(); return &c }(),
+		"SplitMix64.Split":            sm.Split(),
+	} {
+		streams[name] = src
+	}
+	seen := map[uint64]string{}
+	for name, src := range streams {
+		for range SOURCE_SAMPLES {
+			x := src.Uint64()
+			if other, ok := seen[x]; ok {
+				t.Fatalf("Streams %s and %s both output %#x", name, other, x)
+			}
+			seen[x] = name
+		}
+	}
+}
@@ -0,0 +1,286 @@
+// Package zigtest implements goodness-of-fit tests for checking that a sampler draws from its distribution.
+//
+// Each test returns a Result with a p-value, the probability of a result at least as extreme if the samples are drawn from the distribution.
+// Test runs them all at once, for use in a Go test:
+//
+//	if err := zigtest.Test(distribution, ziggurat.ToZiggurat(distribution, src), zigtest.Options{}); err != nil {
+//		t.Error(err)
+//	}
+package zigtest
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+
+	"github.com/argusdusty/ziggurat"
+	"gonum.org/v1/gonum/stat/distuv"
+)
+
+const (
+	DefaultSamples  = 100_000
+	DefaultAlpha    = 0.0001
+	DefaultBins     = 100
+	DefaultTailProb = 0.001
+)
+
+// Options configures Test. The zero value uses the defaults.
+type Options struct {
+	Samples int     // The number of samples to draw, DefaultSamples if zero.
+	Alpha   float64 // The significance level of each test, DefaultAlpha if zero.
+	// The number of equiprobable bins of the chi-squared test, DefaultBins if zero.
+	Bins int
+	// The probability in each tail checked by the tail frequency test, DefaultTailProb if zero.
+	TailProb float64
+	// The raw moments E[X^m] of the distribution, if known, checked for m = 1...MaxMoment.
+	// The moments up to 2*MaxMoment must be finite.
+	Moment    func(m uint64) float64
+	MaxMoment uint64
+}
+
+// A Result is the outcome of a goodness-of-fit test.
+type Result struct {
+	Name      string
+	Statistic float64
+	P         float64 // The p-value. Small values are evidence the samples are not from the distribution.
+}
+
+func (r Result) String() string {
+	return fmt.Sprintf("%s statistic %v has p-value %v", r.Name, r.Statistic, r.P)
+}
+
+// Test draws samples from s and checks them against d with each of the goodness-of-fit tests, and the moments if given.
+// It returns an error describing each test with a p-value below opts.Alpha.
+func Test(d ziggurat.Distribution, s ziggurat.Sampler, opts Options) error {
+	n := cmp.Or(opts.Samples, DefaultSamples)
+	alpha := cmp.Or(opts.Alpha, DefaultAlpha)
+	samples := make([]float64, n)
+	s.Fill(samples)
+	if i := slices.IndexFunc(samples, math.IsNaN); i >= 0 {
+		return fmt.Errorf("zigtest: sample %d of %d is NaN", i, n)
+	}
+	results := []Result{
+		AndersonDarling(samples, d),
+		KolmogorovSmirnov(samples, d),
+		CramerVonMises(samples, d),
+		ChiSquared(samples, d, cmp.Or(opts.Bins, DefaultBins)),
+		TailFrequency(samples, d, cmp.Or(opts.TailProb, DefaultTailProb)),
+	}
+	if opts.Moment != nil {
+		for m := uint64(1); m <= opts.MaxMoment; m++ {
+			results = append(results, Moment(samples, m, opts.Moment(m), opts.Moment(2*m)))
+		}
+	}
+	var errs []error
+	for _, r := range results {
+		if !(r.P >= alpha) {
+			errs = append(errs, fmt.Errorf("zigtest: %d samples failed: %v", n, r))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// The CDF of d, from its CDF method if it has one, which is more accurate in the lower tail than 1-Survival.
+func cdf(d ziggurat.Distribution, x float64) float64 {
+	if c, ok := d.(interface{ CDF(x float64) float64 }); ok {
+		return c.CDF(x)
+	}
+	return 1 - d.Survival(x)
+}
+
+// The CDF of each sample, in increasing order.
+func sortedCDFs(samples []float64, d ziggurat.Distribution) []float64 {
+	u := make([]float64, len(samples))
+	for i, x := range samples {
+		u[i] = cdf(d, x)
+	}
+	slices.Sort(u)
+	return u
+}
+
+// AndersonDarling computes the Anderson-Darling statistic A^2 of samples, which weights the tails more heavily than Kolmogorov-Smirnov or Cramér-von Mises.
+// The p-value is from the approximation of Marsaglia and Marsaglia (2004).
+func AndersonDarling(samples []float64, d ziggurat.Distribution) Result {
+	sorted := slices.Sorted(slices.Values(samples))
+	n := float64(len(sorted))
+	A2 := -n
+	for i := 1; i <= len(sorted); i++ {
+		logCDF := math.Log(cdf(d, sorted[i-1]))
+		logSurvival := math.Log(d.Survival(sorted[len(sorted)-i]))
+		A2 -= float64(i+i-1) / n * (logCDF + logSurvival)
+	}
+	return Result{Name: "Anderson-Darling", Statistic: A2, P: andersonDarlingPValue(A2, n)}
+}
+
+func andersonDarlingPValue(A2, n float64) float64 {
+	g1 := func(x float64) float64 {
+		return math.Sqrt(x) * (1 - x) * (49*x - 102)
+	}
+	g2 := func(x float64) float64 {
+		return -0.00022633 + (6.54034-(14.6538-(14.458-(8.259-1.91864*x)*x)*x)*x)*x
+	}
+	g3 := func(x float64) float64 {
+		return -130.2137 + (745.2337-(1705.091-(1950.646-(1116.360-255.7844*x)*x)*x)*x)*x
+	}
+	var y float64
+	if A2 < 2.0 {
+		y = math.Exp(-1.2337141/A2) * (2.00012 + (0.247105-(0.0649821-(0.0347962-(.0116720-0.00168691*A2)*A2)*A2)*A2)*A2) / math.Sqrt(A2)
+	} else {
+		y = math.Exp(-math.Exp(1.0776 - (2.30695-(.43424-(0.082433-(0.008056-.0003146*A2)*A2)*A2)*A2)*A2))
+	}
+	pv := y
+	if y > 0.8 {
+		pv += g3(y) / n
+	} else {
+		c := 0.01265 + 0.1757/n
+		if y < c {
+			pv += (((0.0037/n+0.00078)/n + 0.00006) / n) * g1(y/c)
+		} else {
+			pv += (0.04213 + 0.01365/n) / n * g2((y-c)/(0.8-c))
+		}
+	}
+	return 1 - pv
+}
+
+// KolmogorovSmirnov computes the Kolmogorov-Smirnov statistic D of samples, the largest distance between the empirical and true CDFs.
+// The p-value is from the asymptotic Kolmogorov distribution, with the small sample correction of Stephens (1970).
+func KolmogorovSmirnov(samples []float64, d ziggurat.Distribution) Result {
+	u := sortedCDFs(samples, d)
+	n := float64(len(u))
+	var D float64
+	for i, c := range u {
+		D = max(D, float64(i+1)/n-c, c-float64(i)/n)
+	}
+	sqrtN := math.Sqrt(n)
+	return Result{Name: "Kolmogorov-Smirnov", Statistic: D, P: kolmogorovSurvival((sqrtN + 0.12 + 0.11/sqrtN) * D)}
+}
+
+// The survival function of the Kolmogorov distribution, from whichever of its two series converges faster.
+func kolmogorovSurvival(x float64) float64 {
+	if x <= 0 {
+		return 1.0
+	}
+	if x < 1.18 {
+		var sum float64
+		for k := 1; k <= 20; k++ {
+			j := float64(2*k - 1)
+			sum += math.Exp(-j * j * math.Pi * math.Pi / (8 * x * x))
+		}
+		return 1 - math.Sqrt(2*math.Pi)/x*sum
+	}
+	var sum float64
+	for k := 1; k <= 20; k++ {
+		term := math.Exp(-2 * float64(k*k) * x * x)
+		if k%2 == 0 {
+			term = -term
+		}
+		sum += term
+	}
+	return 2 * sum
+}
+
+// CramerVonMises computes the Cramér-von Mises statistic W^2 of samples, the integrated squared distance between the empirical and true CDFs.
+// The p-value is from the asymptotic distribution of Anderson and Darling (1952), applied to the statistic modified for sample size by Stephens (1970).
+func CramerVonMises(samples []float64, d ziggurat.Distribution) Result {
+	u := sortedCDFs(samples, d)
+	n := float64(len(u))
+	W2 := 1 / (12 * n)
+	for i, c := range u {
+		e := c - (2*float64(i)+1)/(2*n)
+		W2 += e * e
+	}
+	modified := (W2 - 0.4/n + 0.6/(n*n)) * (1 + 1/n)
+	return Result{Name: "Cramér-von Mises", Statistic: W2, P: 1 - cramerVonMisesCDF(modified)}
+}
+
+// The CDF of the asymptotic Cramér-von Mises distribution, by the series of Anderson and Darling (1952).
+func cramerVonMisesCDF(x float64) float64 {
+	if x <= 0 {
+		return 0.0
+	}
+	var sum float64
+	coef := 1.0 // Gamma(j+1/2)/(Gamma(1/2)*j!)
+	for j := range 100 {
+		k := float64(4*j + 1)
+		z := k * k / (16 * x)
+		term := coef * math.Sqrt(k) * math.Exp(-z) * besselK14(z)
+		sum += term
+		if term < 1e-17*sum {
+			break
+		}
+		coef *= (float64(j) + 0.5) / float64(j+1)
+	}
+	return min(sum/(math.Pi*math.Sqrt(x)), 1)
+}
+
+// The modified Bessel function of the second kind K_{1/4}(z) for z > 0, by the trapezoidal rule on its integral
+// representation, which converges geometrically as the integrand decays doubly exponentially.
+func besselK14(z float64) float64 {
+	const h = 0.05
+	sum := 0.5 * math.Exp(-z)
+	for k := 1; ; k++ {
+		t := float64(k) * h
+		term := math.Exp(-z*math.Cosh(t)) * math.Cosh(t/4)
+		sum += term
+		if term < 1e-17*sum {
+			break
+		}
+	}
+	return sum * h
+}
+
+// ChiSquared computes Pearson's chi-squared statistic of samples counted in bins of equal probability under d.
+// The p-value is from the chi-squared distribution with bins-1 degrees of freedom.
+func ChiSquared(samples []float64, d ziggurat.Distribution, bins int) Result {
+	counts := make([]float64, bins)
+	for _, x := range samples {
+		counts[min(int(cdf(d, x)*float64(bins)), bins-1)]++
+	}
+	expected := float64(len(samples)) / float64(bins)
+	var X2 float64
+	for _, c := range counts {
+		X2 += (c - expected) * (c - expected) / expected
+	}
+	return Result{Name: "chi-squared", Statistic: X2, P: distuv.ChiSquared{K: float64(bins - 1)}.Survival(X2)}
+}
+
+// TailFrequency counts the samples in each tail of d with probability q, which the other tests are insensitive to when q is small.
+// Each count is binomially distributed, and the p-value is the smaller two-sided p-value of the two tails, doubled.
+func TailFrequency(samples []float64, d ziggurat.Distribution, q float64) Result {
+	var lower, upper float64
+	for _, x := range samples {
+		if cdf(d, x) < q {
+			lower++
+		}
+		if d.Survival(x) < q {
+			upper++
+		}
+	}
+	b := distuv.Binomial{N: float64(len(samples)), P: q}
+	p := min(binomialPValue(b, lower), binomialPValue(b, upper))
+	return Result{Name: "tail frequency", Statistic: (lower + upper) / (2 * q * float64(len(samples))), P: min(2*p, 1)}
+}
+
+// The two-sided p-value of the count k from the binomial distribution b.
+func binomialPValue(b distuv.Binomial, k float64) float64 {
+	return min(2*min(b.CDF(k), 1-b.CDF(k-1)), 1)
+}
+
+// Moment computes the sample mean of X^m, and compares it to the true moment exm with the normal approximation,
+// given exm2, the true moment of order 2*m. The p-value is two-sided, and NaN if exm2 <= exm*exm.
+func Moment(samples []float64, m uint64, exm, exm2 float64) Result {
+	name := fmt.Sprintf("E[X^%d]", m)
+	var sum float64
+	for _, x := range samples {
+		sum += math.Pow(x, float64(m))
+	}
+	n := float64(len(samples))
+	mean := sum / n
+	if !(exm2 > exm*exm) {
+		return Result{Name: name, Statistic: mean, P: math.NaN()}
+	}
+	c := distuv.Normal{Mu: exm, Sigma: math.Sqrt((exm2 - exm*exm) / n)}.CDF(mean)
+	return Result{Name: name, Statistic: mean, P: 2 * min(c, 1-c)}
+}
@@ -0,0 +1,59 @@
+package zigtest_test
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"github.com/argusdusty/ziggurat"
+	"github.com/argusdusty/ziggurat/zigtest"
+	"gonum.org/v1/gonum/stat/distuv"
+)
+
+func TestTest(t *testing.T) {
+	for _, test := range []struct {
+		Name      string
+		Dist      ziggurat.Distribution
+		Moment    func(m uint64) float64
+		MaxMoment uint64
+	}{
+		{"Normal", distuv.UnitNormal, func(m uint64) float64 { return [...]float64{1, 0, 1, 0, 3, 0, 15, 0, 105}[m] }, 4},
+		{"Gamma", distuv.Gamma{Alpha: 2, Beta: 1}, func(m uint64) float64 { return [...]float64{1, 2, 6, 24, 120}[m] }, 2},
+		{"StudentsT", distuv.StudentsT{Nu: 3, Mu: 0, Sigma: 1}, nil, 0},
+	} {
+		t.Run(test.Name, func(t *testing.T) {
+			s := ziggurat.ToZiggurat(test.Dist, rand.NewPCG(1, 2))
+			if err := zigtest.Test(test.Dist, s, zigtest.Options{Moment: test.Moment, MaxMoment: test.MaxMoment}); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+// Each test should reject samples from a slightly wrong distribution.
+func TestDetects(t *testing.T) {
+	const ALPHA = 0.0001
+	draw := func(s ziggurat.Sampler) []float64 {
+		samples := make([]float64, zigtest.DefaultSamples)
+		s.Fill(samples)
+		return samples
+	}
+	normal := draw(ziggurat.ToZiggurat(distuv.UnitNormal, rand.NewPCG(1, 2)))
+	wide := distuv.Normal{Mu: 0, Sigma: 1.03}
+	truncated := draw(ziggurat.ToTruncatedZiggurat(distuv.UnitNormal, -3, 3, rand.NewPCG(1, 2)))
+	for _, result := range []zigtest.Result{
+		zigtest.AndersonDarling(normal, wide),
+		zigtest.KolmogorovSmirnov(normal, distuv.Normal{Mu: 0.02, Sigma: 1}),
+		zigtest.CramerVonMises(normal, distuv.Normal{Mu: 0.02, Sigma: 1}),
+		zigtest.ChiSquared(normal, wide, zigtest.DefaultBins),
+		// The body of a normal truncated at 3 sigma is nearly indistinguishable, but its tails are empty.
+		zigtest.TailFrequency(truncated, distuv.UnitNormal, zigtest.DefaultTailProb),
+		zigtest.Moment(normal, 2, 1.03*1.03, 3*1.03*1.03*1.03*1.03),
+	} {
+		if result.P >= ALPHA {
+			t.Errorf("%v, want below %v", result, ALPHA)
+		}
+	}
+	if err := zigtest.Test(distuv.UnitNormal, ziggurat.ToTruncatedZiggurat(distuv.UnitNormal, -3, 3, rand.NewPCG(1, 2)), zigtest.Options{}); err == nil {
+		t.Error("Test of a truncated normal against the normal distribution passed")
+	}
+}